@@ -0,0 +1,84 @@
+package timecode
+
+import (
+	"math"
+)
+
+// AbsDiff returns the distance between a and b, regardless of their order. It
+// is never negative.
+func AbsDiff(a, b Timecode) Timecode {
+	return (a - b).Abs()
+}
+
+// SubClamp returns t - other, or Zero if that would be negative. E.g. removing a
+// delay from a timecode which is smaller than the delay gives Zero.
+func (t Timecode) SubClamp(other Timecode) Timecode {
+	return (t - other).ClampMin(Zero)
+}
+
+// WrapAround returns t modulo 24 hours, for timecodes on a day clock that
+// cross midnight. The result is always valid (see IsValid), so e.g. 25h gives
+// 1h, and -1h gives 23h.
+func (t Timecode) WrapAround() Timecode {
+	const day = 24 * Hour
+	r := t % day
+	if r.IsNegative() {
+		return r + day
+	}
+	return r
+}
+
+// Scale multiplies t by factor, rounding to the nearest millisecond. A
+// negative factor flips the sign of t.
+func (t Timecode) Scale(factor float64) Timecode {
+	return Timecode(math.Round(float64(t) * factor))
+}
+
+// Lerp linearly interpolates between a and b by the fraction t, rounding to
+// the nearest millisecond: t = 0 gives a, t = 1 gives b, and t = 0.5 gives the
+// midpoint. Values of t outside [0, 1] are not clamped, and extrapolate beyond
+// a or b.
+func Lerp(a, b Timecode, t float64) Timecode {
+	return a + (b - a).Scale(t)
+}
+
+// Truncate returns the result of rounding t toward zero to a multiple of unit.
+// If unit is not positive, t is returned unchanged. E.g. truncating 1.5s to
+// Second gives 1s, and -1.5s gives -1s.
+func (t Timecode) Truncate(unit Timecode) Timecode {
+	if unit <= Zero {
+		return t
+	}
+	return t - t%unit
+}
+
+// Round returns the result of rounding t to the nearest multiple of unit.
+// Halfway values round away from zero, as with time.Duration.Round. If unit is
+// not positive, t is returned unchanged.
+func (t Timecode) Round(unit Timecode) Timecode {
+	if unit <= Zero {
+		return t
+	}
+	r := t % unit
+	if r.Abs()*2 < unit {
+		return t - r
+	}
+	if t.IsNegative() {
+		return t - r - unit
+	}
+	return t - r + unit
+}
+
+// Ceil returns the result of rounding t up (toward positive infinity) to a
+// multiple of unit. If unit is not positive, t is returned unchanged. E.g.
+// ceiling 1.5s to Second gives 2s, and -1.5s gives -1s.
+func (t Timecode) Ceil(unit Timecode) Timecode {
+	if unit <= Zero {
+		return t
+	}
+	r := t % unit
+	if r > Zero {
+		return t - r + unit
+	}
+	return t - r
+}
@@ -0,0 +1,212 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbsDiff(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		a        timecode.Timecode
+		b        timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero, timecode.Zero},
+		{timecode.Second, timecode.Second, timecode.Zero},
+		{-timecode.Second, -timecode.Second, timecode.Zero},
+		{timecode.Second, timecode.Minute, 59 * timecode.Second},
+		{timecode.Minute, timecode.Second, 59 * timecode.Second},
+		{-timecode.Second, timecode.Second, 2 * timecode.Second},
+		{timecode.Second, -timecode.Second, 2 * timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.AbsDiff(test.a, test.b)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_SubClamp(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		other    timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero, timecode.Zero},
+		{timecode.Minute, timecode.Second, 59 * timecode.Second},
+		{timecode.Second, timecode.Second, timecode.Zero},
+		{timecode.Second, timecode.Minute, timecode.Zero},
+		{timecode.Second, -timecode.Second, 2 * timecode.Second},
+		{-timecode.Second, timecode.Zero, timecode.Zero},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.SubClamp(test.other)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_WrapAround(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero},
+		{timecode.MaxTimecode, timecode.MaxTimecode},
+		{24 * timecode.Hour, timecode.Zero},
+		{25*timecode.Hour + timecode.Millisecond, timecode.Hour + timecode.Millisecond},
+		{72 * timecode.Hour, timecode.Zero},
+		{-timecode.Millisecond, timecode.MaxTimecode},
+		{-timecode.Hour, 23 * timecode.Hour},
+		{-24 * timecode.Hour, timecode.Zero},
+		{-49 * timecode.Hour, 23 * timecode.Hour},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.WrapAround()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.True(t, actual.IsValid())
+		})
+	}
+}
+
+func TestTimecode_Scale(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		factor   float64
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, 2.0, timecode.Zero},
+		{timecode.Second, 2.0, 2 * timecode.Second},
+		{timecode.Second, 0.5, 500 * timecode.Millisecond},
+		{timecode.Second, -1.0, -timecode.Second},
+		{-timecode.Second, -2.0, 2 * timecode.Second},
+		{timecode.Second, 0.0, timecode.Zero},
+		{10 * timecode.Millisecond, 0.15, 2 * timecode.Millisecond},
+		{timecode.Minute, 100.0 / 90.0, timecode.Timecode(66667)},
+		{timecode.Hour, 25.0 / 23.976, timecode.Timecode(3753754)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Scale(test.factor)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Scale_Identity(t *testing.T) {
+	// Setup expectations
+	var tests = []timecode.Timecode{
+		timecode.Zero,
+		timecode.Millisecond,
+		-timecode.Millisecond,
+		timecode.Timecode(3723456),
+		timecode.Timecode(-3723456),
+		1000 * timecode.Hour,
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.Scale(1.0)
+
+			// Verify result
+			assert.Equal(t, test, actual)
+		})
+	}
+}
+
+func TestLerp(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		a        timecode.Timecode
+		b        timecode.Timecode
+		t        float64
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Second, 0.0, timecode.Zero},
+		{timecode.Zero, timecode.Second, 1.0, timecode.Second},
+		{timecode.Zero, timecode.Second, 0.5, 500 * timecode.Millisecond},
+		{timecode.Second, timecode.Zero, 0.25, 750 * timecode.Millisecond},
+		{-timecode.Second, timecode.Second, 0.5, timecode.Zero},
+		{-timecode.Second, 3 * timecode.Second, 0.5, timecode.Second},
+		{timecode.Zero, timecode.Second, 1.5, 1500 * timecode.Millisecond},
+		{timecode.Zero, timecode.Second, -0.5, -500 * timecode.Millisecond},
+		{timecode.Zero, 10 * timecode.Millisecond, 1.0 / 3.0, 3 * timecode.Millisecond},
+		{timecode.Minute, timecode.Minute, 0.7, timecode.Minute},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.Lerp(test.a, test.b, test.t)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_TruncateRoundCeil(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode         timecode.Timecode
+		unit             timecode.Timecode
+		expectedTruncate timecode.Timecode
+		expectedRound    timecode.Timecode
+		expectedCeil     timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Second, timecode.Zero, timecode.Zero, timecode.Zero},
+		{timecode.Second, timecode.Second, timecode.Second, timecode.Second, timecode.Second},
+		{1400, timecode.Second, timecode.Second, timecode.Second, 2 * timecode.Second},
+		{1500, timecode.Second, timecode.Second, 2 * timecode.Second, 2 * timecode.Second},
+		{1600, timecode.Second, timecode.Second, 2 * timecode.Second, 2 * timecode.Second},
+		{-1400, timecode.Second, -timecode.Second, -timecode.Second, -timecode.Second},
+		{-1500, timecode.Second, -timecode.Second, -2 * timecode.Second, -timecode.Second},
+		{-1600, timecode.Second, -timecode.Second, -2 * timecode.Second, -timecode.Second},
+		{-timecode.Second, timecode.Second, -timecode.Second, -timecode.Second, -timecode.Second},
+		{timecode.Timecode(3723456), timecode.Minute, timecode.Hour + 2*timecode.Minute, timecode.Hour + 2*timecode.Minute, timecode.Hour + 3*timecode.Minute},
+		{timecode.Timecode(3723456), timecode.Zero, timecode.Timecode(3723456), timecode.Timecode(3723456), timecode.Timecode(3723456)},
+		{timecode.Timecode(3723456), -timecode.Second, timecode.Timecode(3723456), timecode.Timecode(3723456), timecode.Timecode(3723456)},
+		{7, 3, 6, 6, 9},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualTruncate := test.timecode.Truncate(test.unit)
+			actualRound := test.timecode.Round(test.unit)
+			actualCeil := test.timecode.Ceil(test.unit)
+
+			// Verify result
+			assert.Equal(t, test.expectedTruncate, actualTruncate, "Truncate")
+			assert.Equal(t, test.expectedRound, actualRound, "Round")
+			assert.Equal(t, test.expectedCeil, actualCeil, "Ceil")
+		})
+	}
+}
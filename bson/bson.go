@@ -0,0 +1,79 @@
+// Package bson provides a BSON codec for Timecode, for use with the official
+// MongoDB Go driver.
+//
+// Timecodes are stored as BSON Int64 values holding the millisecond count, so
+// that they survive a round trip exactly and may be compared and sorted in
+// queries.
+//
+// This package is its own module, so that only its importers need the MongoDB
+// driver.
+package bson
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/liampulles/go-timecode"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var tTimecode = reflect.TypeOf(timecode.Zero)
+
+// RegisterBSONCodecs registers an encoder and decoder for Timecode with the
+// given registry builder.
+func RegisterBSONCodecs(rb *bsoncodec.RegistryBuilder) {
+	rb.RegisterTypeEncoder(tTimecode, bsoncodec.ValueEncoderFunc(EncodeValue))
+	rb.RegisterTypeDecoder(tTimecode, bsoncodec.ValueDecoderFunc(DecodeValue))
+}
+
+// EncodeValue writes a Timecode as a BSON Int64 millisecond count.
+func EncodeValue(_ bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tTimecode {
+		return bsoncodec.ValueEncoderError{
+			Name:     "TimecodeEncodeValue",
+			Types:    []reflect.Type{tTimecode},
+			Received: val,
+		}
+	}
+
+	return vw.WriteInt64(val.Int())
+}
+
+// DecodeValue reads a Timecode from a BSON Int64 (or Int32) millisecond count.
+// A BSON Null is decoded as Zero.
+func DecodeValue(_ bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tTimecode {
+		return bsoncodec.ValueDecoderError{
+			Name:     "TimecodeDecodeValue",
+			Types:    []reflect.Type{tTimecode},
+			Received: val,
+		}
+	}
+
+	var milli int64
+	switch vr.Type() {
+	case bsontype.Int64:
+		i, err := vr.ReadInt64()
+		if err != nil {
+			return err
+		}
+		milli = i
+	case bsontype.Int32:
+		i, err := vr.ReadInt32()
+		if err != nil {
+			return err
+		}
+		milli = int64(i)
+	case bsontype.Null:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot decode BSON type %v into a Timecode", vr.Type())
+	}
+
+	val.SetInt(milli)
+	return nil
+}
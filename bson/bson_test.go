@@ -0,0 +1,86 @@
+package bson_test
+
+import (
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	tcbson "github.com/liampulles/go-timecode/bson"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+)
+
+type document struct {
+	Start timecode.Timecode `bson:"start"`
+	End   timecode.Timecode `bson:"end"`
+}
+
+func registry() *bsoncodec.Registry {
+	rb := bson.NewRegistryBuilder()
+	tcbson.RegisterBSONCodecs(rb)
+	return rb.Build()
+}
+
+func TestRegisterBSONCodecs_RoundTrip(t *testing.T) {
+	// Setup fixture
+	reg := registry()
+	fixture := document{
+		Start: timecode.Timecode(3723456),
+		End:   timecode.Timecode(-3723456),
+	}
+
+	// Exercise SUT
+	b, err := bson.MarshalWithRegistry(reg, fixture)
+	assert.NoError(t, err)
+	var actual document
+	err = bson.UnmarshalWithRegistry(reg, b, &actual)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, fixture, actual)
+}
+
+func TestRegisterBSONCodecs_StoresInt64(t *testing.T) {
+	// Setup fixture
+	reg := registry()
+	fixture := document{Start: timecode.Hour}
+
+	// Exercise SUT
+	b, err := bson.MarshalWithRegistry(reg, fixture)
+
+	// Verify result
+	assert.NoError(t, err)
+	var raw bson.M
+	assert.NoError(t, bson.Unmarshal(b, &raw))
+	assert.Equal(t, int64(3600000), raw["start"])
+}
+
+func TestRegisterBSONCodecs_DecodesInt32AndNull(t *testing.T) {
+	// Setup fixture
+	reg := registry()
+	b, err := bson.Marshal(bson.M{"start": int32(1500), "end": nil})
+	assert.NoError(t, err)
+
+	// Exercise SUT
+	var actual document
+	err = bson.UnmarshalWithRegistry(reg, b, &actual)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, timecode.Timecode(1500), actual.Start)
+	assert.Equal(t, timecode.Zero, actual.End)
+}
+
+func TestRegisterBSONCodecs_InvalidType(t *testing.T) {
+	// Setup fixture
+	reg := registry()
+	b, err := bson.Marshal(bson.M{"start": "01:02:03.456"})
+	assert.NoError(t, err)
+
+	// Exercise SUT
+	var actual document
+	err = bson.UnmarshalWithRegistry(reg, b, &actual)
+
+	// Verify result
+	assert.Error(t, err)
+}
@@ -0,0 +1,11 @@
+module github.com/liampulles/go-timecode/bson
+
+go 1.15
+
+replace github.com/liampulles/go-timecode => ../
+
+require (
+	github.com/liampulles/go-timecode v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.6.1
+	go.mongodb.org/mongo-driver v1.11.9
+)
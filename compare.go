@@ -0,0 +1,117 @@
+package timecode
+
+import (
+	"fmt"
+)
+
+// Compare returns -1 if t is before other, 1 if t is after other, and 0 if
+// they are equal.
+func (t Timecode) Compare(other Timecode) int {
+	switch {
+	case t < other:
+		return -1
+	case t > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Before is true if t is before other.
+func (t Timecode) Before(other Timecode) bool {
+	return t.Compare(other) < 0
+}
+
+// After is true if t is after other.
+func (t Timecode) After(other Timecode) bool {
+	return t.Compare(other) > 0
+}
+
+// Equal is true if t and other are the same Timecode. It is equivalent to ==,
+// and is provided to satisfy Equal-based interfaces and comparers.
+func (t Timecode) Equal(other Timecode) bool {
+	return t.Compare(other) == 0
+}
+
+// ApproxEqual is true if t and other are no more than tolerance apart, in
+// either direction. E.g. got.ApproxEqual(want, 50*Millisecond). Nothing is
+// within a negative tolerance, so that always gives false.
+func (t Timecode) ApproxEqual(other, tolerance Timecode) bool {
+	return AbsDiff(t, other) <= tolerance
+}
+
+// Between is true if t is at or after lo, and at or before hi. Unlike Clamp, it
+// does not panic if lo is after hi, but is simply false.
+func (t Timecode) Between(lo, hi Timecode) bool {
+	return lo <= t && t <= hi
+}
+
+// BetweenExclusive is like Between, but is false if t is equal to lo or hi.
+func (t Timecode) BetweenExclusive(lo, hi Timecode) bool {
+	return lo < t && t < hi
+}
+
+// Clamp returns lo if t is before lo, hi if t is after hi, and t otherwise.
+// It panics if lo is after hi, since that is a programming error.
+func (t Timecode) Clamp(lo, hi Timecode) Timecode {
+	if lo > hi {
+		panic(fmt.Sprintf("timecode: Clamp lower bound [%s] is after upper bound [%s]", lo, hi))
+	}
+	return t.ClampMin(lo).ClampMax(hi)
+}
+
+// ClampMin returns lo if t is before lo, and t otherwise.
+func (t Timecode) ClampMin(lo Timecode) Timecode {
+	if t < lo {
+		return lo
+	}
+	return t
+}
+
+// ClampMax returns hi if t is after hi, and t otherwise.
+func (t Timecode) ClampMax(hi Timecode) Timecode {
+	if t > hi {
+		return hi
+	}
+	return t
+}
+
+// Min returns the earlier of a and b.
+func Min(a, b Timecode) Timecode {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+// Max returns the later of a and b.
+func Max(a, b Timecode) Timecode {
+	if b > a {
+		return b
+	}
+	return a
+}
+
+// MinOf returns the earliest of ts. It panics if ts is empty.
+func MinOf(ts ...Timecode) Timecode {
+	if len(ts) == 0 {
+		panic("timecode: MinOf called with no Timecodes")
+	}
+	result := ts[0]
+	for _, t := range ts[1:] {
+		result = Min(result, t)
+	}
+	return result
+}
+
+// MaxOf returns the latest of ts. It panics if ts is empty.
+func MaxOf(ts ...Timecode) Timecode {
+	if len(ts) == 0 {
+		panic("timecode: MaxOf called with no Timecodes")
+	}
+	result := ts[0]
+	for _, t := range ts[1:] {
+		result = Max(result, t)
+	}
+	return result
+}
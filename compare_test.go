@@ -0,0 +1,274 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_Compare(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		a              timecode.Timecode
+		b              timecode.Timecode
+		expected       int
+		expectedBefore bool
+		expectedAfter  bool
+	}{
+		{timecode.Zero, timecode.Zero, 0, false, false},
+		{timecode.Zero, timecode.Millisecond, -1, true, false},
+		{timecode.Millisecond, timecode.Zero, 1, false, true},
+		{-timecode.Hour, timecode.Millisecond, -1, true, false},
+		{-timecode.Millisecond, -timecode.Hour, 1, false, true},
+		{-timecode.Hour, -timecode.Hour, 0, false, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.a.Compare(test.b)
+			actualBefore := test.a.Before(test.b)
+			actualAfter := test.a.After(test.b)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expectedBefore, actualBefore)
+			assert.Equal(t, test.expectedAfter, actualAfter)
+		})
+	}
+}
+
+func TestTimecode_Equal(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		a timecode.Timecode
+		b timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero},
+		{timecode.Zero, -timecode.Zero},
+		{timecode.Zero, timecode.Millisecond},
+		{timecode.Millisecond, -timecode.Millisecond},
+		{timecode.Hour, 60 * timecode.Minute},
+		{timecode.Timecode(3723456), timecode.Timecode(3723457)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.a.Equal(test.b)
+
+			// Verify result
+			assert.Equal(t, test.a == test.b, actual)
+			assert.Equal(t, actual, test.b.Equal(test.a))
+		})
+	}
+}
+
+func TestTimecode_ApproxEqual(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode  timecode.Timecode
+		other     timecode.Timecode
+		tolerance timecode.Timecode
+		expected  bool
+	}{
+		{timecode.Zero, timecode.Zero, timecode.Zero, true},
+		{timecode.Zero, timecode.Millisecond, timecode.Zero, false},
+		{timecode.Second, timecode.Second + 50*timecode.Millisecond, 50 * timecode.Millisecond, true},
+		{timecode.Second, timecode.Second - 50*timecode.Millisecond, 50 * timecode.Millisecond, true},
+		{timecode.Second, timecode.Second + 51*timecode.Millisecond, 50 * timecode.Millisecond, false},
+		{-25 * timecode.Millisecond, 25 * timecode.Millisecond, 50 * timecode.Millisecond, true},
+		{timecode.Second, timecode.Second, -timecode.Millisecond, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.ApproxEqual(test.other, test.tolerance)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, actual, test.other.ApproxEqual(test.timecode, test.tolerance))
+		})
+	}
+}
+
+func TestTimecode_Between(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode          timecode.Timecode
+		lo                timecode.Timecode
+		hi                timecode.Timecode
+		expected          bool
+		expectedExclusive bool
+	}{
+		{timecode.Second, timecode.Zero, timecode.Minute, true, true},
+		{timecode.Zero, timecode.Zero, timecode.Minute, true, false},
+		{timecode.Minute, timecode.Zero, timecode.Minute, true, false},
+		{timecode.Hour, timecode.Zero, timecode.Minute, false, false},
+		{-timecode.Second, timecode.Zero, timecode.Minute, false, false},
+		{timecode.Second, timecode.Second, timecode.Second, true, false},
+		{timecode.Second, timecode.Minute, timecode.Zero, false, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Between(test.lo, test.hi)
+			actualExclusive := test.timecode.BetweenExclusive(test.lo, test.hi)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expectedExclusive, actualExclusive)
+		})
+	}
+}
+
+func TestTimecode_Clamp(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		lo       timecode.Timecode
+		hi       timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Second, timecode.Zero, timecode.Minute, timecode.Second},
+		{-timecode.Second, timecode.Zero, timecode.Minute, timecode.Zero},
+		{timecode.Hour, timecode.Zero, timecode.Minute, timecode.Minute},
+		{timecode.Zero, timecode.Zero, timecode.Minute, timecode.Zero},
+		{timecode.Minute, timecode.Zero, timecode.Minute, timecode.Minute},
+		{timecode.Hour, timecode.Second, timecode.Second, timecode.Second},
+		{-timecode.Hour, -timecode.Minute, -timecode.Second, -timecode.Minute},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Clamp(test.lo, test.hi)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Clamp_InvalidBounds(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { timecode.Zero.Clamp(timecode.Minute, timecode.Second) })
+}
+
+func TestTimecode_ClampMin(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		lo       timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Second, timecode.Zero, timecode.Second},
+		{-timecode.Second, timecode.Zero, timecode.Zero},
+		{timecode.Zero, timecode.Zero, timecode.Zero},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.ClampMin(test.lo)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_ClampMax(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		hi       timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Second, timecode.Minute, timecode.Second},
+		{timecode.Hour, timecode.Minute, timecode.Minute},
+		{timecode.Minute, timecode.Minute, timecode.Minute},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.ClampMax(test.hi)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		a           timecode.Timecode
+		b           timecode.Timecode
+		expectedMin timecode.Timecode
+		expectedMax timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero, timecode.Zero, timecode.Zero},
+		{timecode.Second, timecode.Minute, timecode.Second, timecode.Minute},
+		{timecode.Minute, timecode.Second, timecode.Second, timecode.Minute},
+		{-timecode.Minute, timecode.Second, -timecode.Minute, timecode.Second},
+		{-timecode.Second, -timecode.Minute, -timecode.Minute, -timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualMin := timecode.Min(test.a, test.b)
+			actualMax := timecode.Max(test.a, test.b)
+
+			// Verify result
+			assert.Equal(t, test.expectedMin, actualMin)
+			assert.Equal(t, test.expectedMax, actualMax)
+		})
+	}
+}
+
+func TestMinOfMaxOf(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		ts          []timecode.Timecode
+		expectedMin timecode.Timecode
+		expectedMax timecode.Timecode
+	}{
+		{
+			[]timecode.Timecode{timecode.Second},
+			timecode.Second, timecode.Second,
+		},
+		{
+			[]timecode.Timecode{timecode.Minute, -timecode.Hour, timecode.Zero, timecode.Hour, timecode.Second},
+			-timecode.Hour, timecode.Hour,
+		},
+		{
+			[]timecode.Timecode{-timecode.Second, -timecode.Minute, -timecode.Millisecond},
+			-timecode.Minute, -timecode.Millisecond,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualMin := timecode.MinOf(test.ts...)
+			actualMax := timecode.MaxOf(test.ts...)
+
+			// Verify result
+			assert.Equal(t, test.expectedMin, actualMin)
+			assert.Equal(t, test.expectedMax, actualMax)
+		})
+	}
+}
+
+func TestMinOfMaxOf_Empty(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { timecode.MinOf() })
+	assert.Panics(t, func() { timecode.MaxOf() })
+}
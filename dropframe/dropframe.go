@@ -0,0 +1,162 @@
+// Package dropframe provides SMPTE drop-frame timecodes for the 29.97 and
+// 59.94 fps NTSC frame rates.
+//
+// Drop-frame timecode skips frame numbers 00 and 01 (00 to 03 at 59.94 fps)
+// at the start of each minute, except for every tenth minute, so that the
+// timecode keeps pace with the wall clock even though the frame rate is not
+// a whole number.
+package dropframe
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+
+	"github.com/liampulles/go-timecode"
+)
+
+// Regex can be used to validate drop-frame timecodes and capture the hours,
+// minutes, seconds, and frames groups. Per SMPTE 12-1, the frames are
+// separated by a semicolon.
+var Regex = regexp.MustCompile(`^(\d{2,}):([012345]\d):([012345]\d);(\d{2})$`)
+
+// DropFrameTimecode is an SMPTE drop-frame timecode, e.g. "01:02:03;04".
+//
+// Rate must be timecode.NTSC2997() or timecode.NTSC5994(). The zero value of
+// Rate is taken to be timecode.NTSC2997().
+type DropFrameTimecode struct {
+	Hour   uint
+	Minute uint
+	Second uint
+	Frame  uint
+	Rate   timecode.FrameRate
+}
+
+// Check we implement the interface
+var _ fmt.Stringer = DropFrameTimecode{}
+
+type scheme struct {
+	nominal uint
+	drop    uint
+}
+
+// ToTimecode converts d into a timecode.Timecode, rounding to the nearest
+// millisecond. It panics if d.Rate is not a drop-frame rate.
+func (d DropFrameTimecode) ToTimecode() timecode.Timecode {
+	sch := mustScheme(d.Rate)
+	num, den := rate(d.Rate).FramesPerSecond()
+
+	totalMinutes := 60*d.Hour + d.Minute
+	frame := (3600*d.Hour+60*d.Minute+d.Second)*sch.nominal + d.Frame
+	frame -= sch.drop * (totalMinutes - totalMinutes/10)
+
+	return timecode.Timecode(math.Round(float64(frame) * 1000 * float64(den) / float64(num)))
+}
+
+// FromTimecode converts t into a DropFrameTimecode at fps, rounding to the
+// nearest frame. The zero value of fps is taken to be timecode.NTSC2997(). It
+// panics if t is negative, or if fps is not a drop-frame rate.
+func FromTimecode(t timecode.Timecode, fps timecode.FrameRate) DropFrameTimecode {
+	sch := mustScheme(fps)
+	if t.IsNegative() {
+		panic(fmt.Sprintf("dropframe: cannot convert negative timecode [%s]", t))
+	}
+	fps = rate(fps)
+	num, den := fps.FramesPerSecond()
+
+	frame := uint(math.Round(float64(t) * float64(num) / (1000 * float64(den))))
+	framesPerMinute := 60*sch.nominal - sch.drop
+	framesPer10Minutes := 10*framesPerMinute + sch.drop
+	tens := frame / framesPer10Minutes
+	rem := frame % framesPer10Minutes
+	frame += 9 * sch.drop * tens
+	if rem > sch.drop {
+		frame += sch.drop * ((rem - sch.drop) / framesPerMinute)
+	}
+
+	return DropFrameTimecode{
+		Hour:   frame / (3600 * sch.nominal),
+		Minute: (frame / (60 * sch.nominal)) % 60,
+		Second: (frame / sch.nominal) % 60,
+		Frame:  frame % sch.nominal,
+		Rate:   fps,
+	}
+}
+
+// Parse parses str, e.g. "01:02:03;04", into d at d.Rate. An error is returned
+// if str is not of that form, if its hours do not fit in 32 bits, if it names
+// a frame which is dropped or beyond the frame rate, or if d.Rate is not a
+// drop-frame rate. d is left unchanged if an error is returned.
+func (d *DropFrameTimecode) Parse(str string) error {
+	sch, err := schemeFor(d.Rate)
+	if err != nil {
+		return err
+	}
+	m := Regex.FindStringSubmatch(str)
+	if len(m) == 0 {
+		return fmt.Errorf("[%s] is not a drop-frame timecode", str)
+	}
+	if _, err := strconv.ParseUint(m[1], 10, 32); err != nil {
+		return fmt.Errorf("[%s] has too many hours", str)
+	}
+
+	parsed := DropFrameTimecode{
+		Hour:   parseNumber(m[1]),
+		Minute: parseNumber(m[2]),
+		Second: parseNumber(m[3]),
+		Frame:  parseNumber(m[4]),
+		Rate:   d.Rate,
+	}
+	if parsed.Frame >= sch.nominal {
+		return fmt.Errorf("[%s] has frame [%d], but there are only [%d] frames per second",
+			str, parsed.Frame, sch.nominal)
+	}
+	if parsed.Second == 0 && parsed.Minute%10 != 0 && parsed.Frame < sch.drop {
+		return fmt.Errorf("[%s] is a dropped frame", str)
+	}
+
+	*d = parsed
+	return nil
+}
+
+// Format formats d as e.g. "01:02:03;04".
+func (d DropFrameTimecode) Format() string {
+	return fmt.Sprintf("%02d:%02d:%02d;%02d", d.Hour, d.Minute, d.Second, d.Frame)
+}
+
+// String is the same as Format.
+func (d DropFrameTimecode) String() string {
+	return d.Format()
+}
+
+func rate(fps timecode.FrameRate) timecode.FrameRate {
+	if fps == (timecode.FrameRate{}) {
+		return timecode.NTSC2997()
+	}
+	return fps
+}
+
+func schemeFor(fps timecode.FrameRate) (scheme, error) {
+	switch rate(fps) {
+	case timecode.NTSC2997():
+		return scheme{nominal: 30, drop: 2}, nil
+	case timecode.NTSC5994():
+		return scheme{nominal: 60, drop: 4}, nil
+	default:
+		return scheme{}, fmt.Errorf("[%s] is not a drop-frame rate", fps)
+	}
+}
+
+func mustScheme(fps timecode.FrameRate) scheme {
+	sch, err := schemeFor(fps)
+	if err != nil {
+		panic(fmt.Sprintf("dropframe: %v", err))
+	}
+	return sch
+}
+
+func parseNumber(value string) uint {
+	result, _ := strconv.ParseUint(value, 10, 64)
+	return uint(result)
+}
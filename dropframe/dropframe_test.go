@@ -0,0 +1,184 @@
+package dropframe_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/liampulles/go-timecode/dropframe"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromTimecode(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		fps      timecode.FrameRate
+		expected string
+	}{
+		{timecode.Zero, timecode.NTSC2997(), "00:00:00;00"},
+		{timecode.Timecode(33), timecode.NTSC2997(), "00:00:00;01"},
+		{timecode.Timecode(60027), timecode.NTSC2997(), "00:00:59;29"},
+		{timecode.Timecode(60060), timecode.NTSC2997(), "00:01:00;02"},
+		{timecode.Timecode(599966), timecode.NTSC2997(), "00:09:59;29"},
+		{timecode.Timecode(600000), timecode.NTSC2997(), "00:10:00;00"},
+		{timecode.Timecode(660059), timecode.NTSC2997(), "00:11:00;02"},
+		{timecode.Timecode(3599996), timecode.NTSC2997(), "01:00:00;00"},
+		{timecode.Timecode(60060), timecode.NTSC5994(), "00:01:00;04"},
+		{timecode.Timecode(3599996), timecode.NTSC5994(), "01:00:00;00"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := dropframe.FromTimecode(test.timecode, test.fps)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual.Format())
+			assert.Equal(t, test.fps, actual.Rate)
+		})
+	}
+}
+
+func TestFromTimecode_ZeroRate(t *testing.T) {
+	// Exercise SUT
+	actual := dropframe.FromTimecode(timecode.Timecode(60060), timecode.FrameRate{})
+
+	// Verify result
+	assert.Equal(t, "00:01:00;02", actual.Format())
+	assert.Equal(t, timecode.NTSC2997(), actual.Rate)
+}
+
+func TestFromTimecode_InvalidCases(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { dropframe.FromTimecode(timecode.Second, timecode.PAL25()) })
+	assert.Panics(t, func() { dropframe.FromTimecode(-timecode.Second, timecode.NTSC2997()) })
+}
+
+func TestDropFrameTimecode_ToTimecode(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		d        dropframe.DropFrameTimecode
+		expected timecode.Timecode
+	}{
+		{dropframe.DropFrameTimecode{}, timecode.Zero},
+		{dropframe.DropFrameTimecode{Minute: 1, Frame: 2}, timecode.Timecode(60060)},
+		{dropframe.DropFrameTimecode{Minute: 10, Rate: timecode.NTSC2997()}, timecode.Timecode(599999)},
+		{dropframe.DropFrameTimecode{Hour: 1, Rate: timecode.NTSC2997()}, timecode.Timecode(3599996)},
+		{dropframe.DropFrameTimecode{Minute: 1, Frame: 4, Rate: timecode.NTSC5994()}, timecode.Timecode(60060)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.d.ToTimecode()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestDropFrameTimecode_ToTimecode_InvalidRate(t *testing.T) {
+	// Setup fixture
+	sut := dropframe.DropFrameTimecode{Rate: timecode.PAL25()}
+
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { sut.ToTimecode() })
+}
+
+func TestDropFrameTimecode_RoundTrip(t *testing.T) {
+	// Every frame of the first 11 minutes should survive a round trip.
+	for _, fps := range []timecode.FrameRate{timecode.NTSC2997(), timecode.NTSC5994()} {
+		num, _ := fps.FramesPerSecond()
+		prev := ""
+		for frame := 0; frame < 11*60*int(num)/1000; frame++ {
+			// Setup fixture
+			tc := timecode.Timecode(float64(frame)*1001000/float64(num) + 0.5)
+
+			// Exercise SUT
+			d := dropframe.FromTimecode(tc, fps)
+			actual := dropframe.FromTimecode(d.ToTimecode(), fps)
+
+			// Verify result
+			if !assert.Equal(t, d, actual, "fps %s frame %d", fps, frame) ||
+				!assert.NotEqual(t, prev, d.Format(), "fps %s frame %d", fps, frame) {
+				return
+			}
+			prev = d.Format()
+		}
+	}
+}
+
+func TestDropFrameTimecode_Parse_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		fps      timecode.FrameRate
+		expected dropframe.DropFrameTimecode
+	}{
+		{"00:00:00;00", timecode.FrameRate{}, dropframe.DropFrameTimecode{}},
+		{"01:02:03;04", timecode.NTSC2997(), dropframe.DropFrameTimecode{Hour: 1, Minute: 2, Second: 3, Frame: 4, Rate: timecode.NTSC2997()}},
+		{"00:01:00;02", timecode.NTSC2997(), dropframe.DropFrameTimecode{Minute: 1, Frame: 2, Rate: timecode.NTSC2997()}},
+		{"00:10:00;00", timecode.NTSC2997(), dropframe.DropFrameTimecode{Minute: 10, Rate: timecode.NTSC2997()}},
+		{"00:01:00;04", timecode.NTSC5994(), dropframe.DropFrameTimecode{Minute: 1, Frame: 4, Rate: timecode.NTSC5994()}},
+		{"00:00:00;59", timecode.NTSC5994(), dropframe.DropFrameTimecode{Frame: 59, Rate: timecode.NTSC5994()}},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := dropframe.DropFrameTimecode{Rate: test.fps}
+
+			// Exercise SUT
+			err := sut.Parse(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, sut)
+		})
+	}
+}
+
+func TestDropFrameTimecode_Parse_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str string
+		fps timecode.FrameRate
+	}{
+		{"", timecode.NTSC2997()},
+		{"00:00:00:00", timecode.NTSC2997()},
+		{"00:00:00;30", timecode.NTSC2997()},
+		{"00:01:00;00", timecode.NTSC2997()},
+		{"00:01:00;01", timecode.NTSC2997()},
+		{"00:01:00;03", timecode.NTSC5994()},
+		{"00:60:00;00", timecode.NTSC2997()},
+		{"00:00:00;00", timecode.PAL25()},
+		{"99999999999999999999:00:00;00", timecode.NTSC2997()},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := dropframe.DropFrameTimecode{Hour: 9, Rate: test.fps}
+
+			// Exercise SUT
+			err := sut.Parse(test.str)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, dropframe.DropFrameTimecode{Hour: 9, Rate: test.fps}, sut)
+		})
+	}
+}
+
+func TestDropFrameTimecode_String(t *testing.T) {
+	// Setup fixture
+	sut := dropframe.DropFrameTimecode{Hour: 1, Minute: 2, Second: 3, Frame: 4}
+
+	// Exercise SUT
+	actual := sut.String()
+
+	// Verify result
+	assert.Equal(t, "01:02:03;04", actual)
+}
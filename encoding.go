@@ -0,0 +1,107 @@
+package timecode
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Check we implement the interfaces
+var _ encoding.TextMarshaler = Zero
+var _ encoding.TextUnmarshaler = (*Timecode)(nil)
+var _ json.Marshaler = Zero
+var _ json.Unmarshaler = (*Timecode)(nil)
+var _ gob.GobEncoder = Zero
+var _ gob.GobDecoder = (*Timecode)(nil)
+
+// gobVersion is the first byte of the gob encoding, so that the encoding may
+// change in future without breaking existing streams.
+const gobVersion byte = 1
+
+// textGrammar matches the whole of a MarshalText encoding, which may have
+// hours beyond a 24 hour clock.
+var textGrammar = newGrammar(RegexWideHours, ".")
+
+// MarshalText encodes t in the FormatDot format, e.g. "01:02:03.456". Hours
+// are not limited to a 24 hour clock, e.g. "123:45:12.000".
+func (t Timecode) MarshalText() ([]byte, error) {
+	return []byte(t.FormatDot()), nil
+}
+
+// UnmarshalText decodes the MarshalText encoding into t. Unlike Parse, b must
+// consist of the timecode alone, but hours beyond 23 are accepted (see
+// RegexWideHours), so that every MarshalText encoding can be read back. t is
+// left unchanged if b is not a valid timecode.
+func (t *Timecode) UnmarshalText(b []byte) error {
+	str := string(b)
+	m := textGrammar.anchored.FindStringSubmatch(str)
+	if len(m) == 0 {
+		return newParseError(str, textGrammar)
+	}
+	if _, err := strconv.ParseUint(m[2], 10, 32); err != nil {
+		return fmt.Errorf("[%s] has too many hours", str)
+	}
+	*t = fromRegexMatch(m)
+	return nil
+}
+
+// MarshalJSON encodes t as a JSON string in the FormatDot format, e.g.
+// "01:02:03.456".
+func (t Timecode) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, 16)
+	b = append(b, '"')
+	b = t.AppendFormat(b, true, ".")
+	return append(b, '"'), nil
+}
+
+// UnmarshalJSON decodes either a JSON string (as per UnmarshalText) or a JSON
+// integer (as a count of milliseconds) into t. A JSON null leaves t unchanged,
+// as is the convention for encoding/json. t is also left unchanged if an error
+// is returned.
+func (t *Timecode) UnmarshalJSON(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
+	if string(trimmed) == "null" {
+		return nil
+	}
+
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var str string
+		if err := json.Unmarshal(trimmed, &str); err != nil {
+			return err
+		}
+		return t.UnmarshalText([]byte(str))
+	}
+
+	milli, err := strconv.ParseInt(string(trimmed), 10, 64)
+	if err != nil {
+		return fmt.Errorf("[%s] is not a JSON timecode string or millisecond integer", b)
+	}
+	*t = Timecode(milli)
+	return nil
+}
+
+// GobEncode encodes t for encoding/gob. The encoding is a version byte (1)
+// followed by the millisecond count as a big-endian int64.
+func (t Timecode) GobEncode() ([]byte, error) {
+	b := make([]byte, 9)
+	b[0] = gobVersion
+	binary.BigEndian.PutUint64(b[1:], uint64(t))
+	return b, nil
+}
+
+// GobDecode decodes the GobEncode encoding into t. t is left unchanged if an
+// error is returned.
+func (t *Timecode) GobDecode(b []byte) error {
+	if len(b) == 0 || b[0] != gobVersion {
+		return fmt.Errorf("unsupported timecode gob encoding")
+	}
+	if len(b) != 9 {
+		return fmt.Errorf("timecode gob encoding has invalid length [%d]", len(b))
+	}
+	*t = Timecode(binary.BigEndian.Uint64(b[1:]))
+	return nil
+}
@@ -0,0 +1,295 @@
+package timecode_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_MarshalText(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-3723456)
+
+	// Exercise SUT
+	actual, err := sut.MarshalText()
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, "-01:02:03.456", string(actual))
+}
+
+func TestTimecode_UnmarshalText_ValidCase(t *testing.T) {
+	// Setup fixture
+	var sut timecode.Timecode
+
+	// Exercise SUT
+	err := sut.UnmarshalText([]byte("01:02:03,456"))
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, timecode.Timecode(3723456), sut)
+}
+
+func TestTimecode_UnmarshalText_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"not.a.timecode",
+		"",
+		"crouching.tiger.00:00:01.hidden.timecode",
+		" 00:00:01.000",
+		"0:00:01.000",
+		"00:60:00.000",
+		"99999999999:00:00.000",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Second
+
+			// Exercise SUT
+			err := sut.UnmarshalText([]byte(test))
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Second, sut)
+		})
+	}
+}
+
+func TestTimecode_UnmarshalText_ParseErrorOffset(t *testing.T) {
+	// Setup fixture
+	var sut timecode.Timecode
+
+	// Exercise SUT
+	err := sut.UnmarshalText([]byte("123:45:5x"))
+
+	// Verify result
+	var parseErr *timecode.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, &timecode.ParseError{Input: "123:45:5x", Offset: 8}, parseErr)
+}
+
+func TestTimecode_TextRoundTrip(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "00:00:00.000"},
+		{timecode.Timecode(-3723456), "-01:02:03.456"},
+		{24 * timecode.Hour, "24:00:00.000"},
+		{25 * timecode.Hour, "25:00:00.000"},
+		{99*timecode.Hour + 59*timecode.Minute + 59*timecode.Second + 999*timecode.Millisecond, "99:59:59.999"},
+		{123*timecode.Hour + 45*timecode.Minute + 12*timecode.Second, "123:45:12.000"},
+		{-100 * timecode.Hour, "-100:00:00.000"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			b, err := test.timecode.MarshalText()
+			assert.NoError(t, err)
+			var actual timecode.Timecode
+			err = actual.UnmarshalText(b)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+			assert.Equal(t, test.timecode, actual)
+		})
+	}
+}
+
+func TestTimecode_JSONRoundTrip(t *testing.T) {
+	// Setup fixture
+	type cue struct {
+		Start timecode.Timecode `json:"start"`
+	}
+	fixture := cue{Start: timecode.Timecode(3723456)}
+
+	// Exercise SUT
+	b, err := json.Marshal(fixture)
+	assert.NoError(t, err)
+	var actual cue
+	err = json.Unmarshal(b, &actual)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, `{"start":"01:02:03.456"}`, string(b))
+	assert.Equal(t, fixture, actual)
+}
+
+func TestTimecode_JSONRoundTrip_WideHours(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{24 * timecode.Hour, `"24:00:00.000"`},
+		{123*timecode.Hour + 45*timecode.Minute + 12*timecode.Second, `"123:45:12.000"`},
+		{-100 * timecode.Hour, `"-100:00:00.000"`},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			b, err := json.Marshal(test.timecode)
+			assert.NoError(t, err)
+			var actual timecode.Timecode
+			err = json.Unmarshal(b, &actual)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, string(b))
+			assert.Equal(t, test.timecode, actual)
+		})
+	}
+}
+
+func TestTimecode_MarshalJSON(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-3723456)
+
+	// Exercise SUT
+	actual, err := sut.MarshalJSON()
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, `"-01:02:03.456"`, string(actual))
+}
+
+func TestTimecode_UnmarshalJSON_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		json     string
+		expected timecode.Timecode
+	}{
+		{`"01:02:03.456"`, timecode.Timecode(3723456)},
+		{`"-01:02:03,456"`, timecode.Timecode(-3723456)},
+		{`"\u0030\u0030:00:01"`, timecode.Second},
+		{`3723456`, timecode.Timecode(3723456)},
+		{`-1`, -timecode.Millisecond},
+		{`0`, timecode.Zero},
+		{`null`, timecode.Hour},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Hour
+
+			// Exercise SUT
+			err := sut.UnmarshalJSON([]byte(test.json))
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, sut)
+		})
+	}
+}
+
+func TestTimecode_UnmarshalJSON_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		``,
+		`"not.a.timecode"`,
+		`"01:02:03.456`,
+		`1.5`,
+		`true`,
+		`{}`,
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Hour
+
+			// Exercise SUT
+			err := sut.UnmarshalJSON([]byte(test))
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Hour, sut)
+		})
+	}
+}
+
+func TestTimecode_UnmarshalJSON_Struct(t *testing.T) {
+	// Setup fixture
+	type cue struct {
+		Start timecode.Timecode `json:"start"`
+		End   timecode.Timecode `json:"end"`
+	}
+	var actual cue
+
+	// Exercise SUT
+	err := json.Unmarshal([]byte(`{"start": 1500, "end": "00:00:02.500"}`), &actual)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, cue{Start: 1500, End: 2500}, actual)
+}
+
+func TestTimecode_GobEncode(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-2)
+
+	// Exercise SUT
+	actual, err := sut.GobEncode()
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}, actual)
+}
+
+func TestTimecode_GobDecode_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = [][]byte{
+		nil,
+		{},
+		{2, 0, 0, 0, 0, 0, 0, 0, 1},
+		{1, 0, 0, 0, 1},
+		{1, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Hour
+
+			// Exercise SUT
+			err := sut.GobDecode(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Hour, sut)
+		})
+	}
+}
+
+func TestTimecode_GobRoundTrip(t *testing.T) {
+	// Setup fixture
+	type cue struct {
+		Start timecode.Timecode
+		End   timecode.Timecode
+	}
+	fixture := cue{Start: timecode.Timecode(-3723456), End: timecode.Timecode(3723456)}
+	var buf bytes.Buffer
+
+	// Exercise SUT
+	err := gob.NewEncoder(&buf).Encode(fixture)
+	assert.NoError(t, err)
+	var actual cue
+	err = gob.NewDecoder(&buf).Decode(&actual)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, fixture, actual)
+}
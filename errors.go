@@ -0,0 +1,109 @@
+package timecode
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ParseError describes a string which could not be parsed as a Timecode. It
+// is returned by Parse (see Parser.Parse for its use with ParseOptions), and
+// may be extracted from wrapped errors with errors.As.
+type ParseError struct {
+	// Input is the string which was being parsed.
+	Input string
+	// Offset is the byte position in Input where the most complete partial
+	// timecode stopped matching (which may be len(Input) if Input ended too
+	// soon), or -1 if nothing resembling a timecode was found.
+	Offset int
+}
+
+// Check we implement the interface
+var _ error = (*ParseError)(nil)
+
+// Error formats as e.g. "[00:0d:00] is not a timecode".
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("[%s] is not a timecode", e.Input)
+}
+
+func newParseError(str string, g grammar) *ParseError {
+	return &ParseError{Input: str, Offset: g.mismatchOffset(str)}
+}
+
+func isTimecodeByte(c byte) bool {
+	return ('0' <= c && c <= '9') || c == ':'
+}
+
+// grammar finds partial matches of a timecode pattern, e.g. for
+// ParseError.Offset, so that they always agree with the pattern itself. A
+// string is a partial match if appending some suffix of example gives a full
+// match, where example is a full match of the longest form of the pattern made
+// only of zeros and separators. This holds since a zero fits every digit of a
+// timecode.
+type grammar struct {
+	anchored *regexp.Regexp
+	example  string
+}
+
+// defaultGrammar is the grammar of Regex.
+var defaultGrammar = newGrammar(Regex, ".")
+
+func newGrammar(re *regexp.Regexp, milliSeperator string) grammar {
+	return grammar{
+		anchored: regexp.MustCompile(`^(?:` + re.String() + `)$`),
+		example:  "00:00:00" + milliSeperator + "000",
+	}
+}
+
+// isPartial is true if str can be extended into a full match (including if it
+// already is one).
+func (g grammar) isPartial(str string) bool {
+	return g.completes(str, len(g.example))
+}
+
+// isExtensible is true if str can be extended into a longer full match.
+func (g grammar) isExtensible(str string) bool {
+	return g.completes(str, len(g.example)-1)
+}
+
+// mismatchOffset finds where the longest partial match of g in str stopped
+// matching, or -1 if no position in str matched even one digit. Only matches
+// which do not start partway through a run of digits and colons are
+// considered, so that e.g. "24:00:00" fails at the "4" rather than the end.
+func (g grammar) mismatchOffset(str string) int {
+	best, bestProgress := -1, 0
+	for i := 0; i < len(str); i++ {
+		if i > 0 && isTimecodeByte(str[i-1]) {
+			continue
+		}
+
+		end := i
+		for end < len(str) && g.isPartial(str[i:end+1]) {
+			end++
+		}
+		progress := end - i
+		if progress > 0 && str[i] == '-' {
+			progress--
+		}
+
+		if progress > bestProgress {
+			best, bestProgress = end, progress
+		}
+	}
+	return best
+}
+
+// isFull is true if str is a full match.
+func (g grammar) isFull(str string) bool {
+	return g.anchored.MatchString(str)
+}
+
+// completes is true if str followed by some suffix of example, starting at
+// or before last, is a full match.
+func (g grammar) completes(str string, last int) bool {
+	for i := 0; i <= last; i++ {
+		if g.anchored.MatchString(str + g.example[i:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package timecode_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_ParseError(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str            string
+		expectedOffset int
+	}{
+		{"", -1},
+		{"not.a.timecode", -1},
+		{"-", -1},
+		{"00:0d:00", 4},
+		{"00:00:0d", 7},
+		{"00:00", 5},
+		{"-00:00:-00", 7},
+		{"24:00:00", 1},
+		{"at 12:61:00", 6},
+		{"00:0d and 00:00:", 16},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			_, err := timecode.Parse(test.str)
+
+			// Verify result
+			var parseErr *timecode.ParseError
+			assert.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, test.str, parseErr.Input)
+			assert.Equal(t, test.expectedOffset, parseErr.Offset)
+			assert.Equal(t, fmt.Sprintf("[%s] is not a timecode", test.str), err.Error())
+		})
+	}
+}
+
+func TestParseError_Wrapped(t *testing.T) {
+	// Exercise SUT
+	_, err := timecode.ParseManyStrict([]string{"00:00:01", "00:0x:00"})
+
+	// Verify result
+	var parseErr *timecode.ParseError
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, &timecode.ParseError{Input: "00:0x:00", Offset: 4}, parseErr)
+}
@@ -0,0 +1,49 @@
+package timecode
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ffmpegRegex matches the ffmpeg duration syntax, capturing the sign, hours
+// (optional), minutes, and seconds of the clock form, or the seconds of the
+// plain form, followed by the fractional seconds of either.
+var ffmpegRegex = regexp.MustCompile(
+	`^(-)?(?:(?:(\d+):)?([012345]\d):([012345]\d)|(\d+))(?:\.(\d+))?$`)
+
+// FormatFFmpeg formats t for use as an ffmpeg duration argument (e.g. for -ss
+// or -t), e.g. "01:02:03.004". Hours are always included, the milliseconds are
+// always separated by a dot, and negative Timecodes are prefixed with "-".
+func (t Timecode) FormatFFmpeg() string {
+	return t.Format(true, ".")
+}
+
+// ParseFFmpeg extracts a Timecode from an ffmpeg duration argument. Both the
+// clock form "[-][HH:]MM:SS[.m...]" (e.g. "01:02:03.004" or "02:03") and the
+// plain seconds form "[-]S+[.m...]" (e.g. "90.5") are accepted. Hours are not
+// limited to a 24 hour clock. Fractions of a second beyond milliseconds are
+// truncated.
+func ParseFFmpeg(str string) (Timecode, error) {
+	m := ffmpegRegex.FindStringSubmatch(str)
+	if len(m) == 0 {
+		return Zero, fmt.Errorf("[%s] is not an ffmpeg duration", str)
+	}
+
+	for _, i := range []int{2, 5} {
+		if isNotEmpty(m, i) {
+			if _, err := strconv.ParseUint(m[i], 10, 32); err != nil {
+				return Zero, fmt.Errorf("[%s] has a value which is too large", str)
+			}
+		}
+	}
+
+	negative := isNotEmpty(m, 1)
+	hour := parseNumber(m, 2)
+	minute := parseNumber(m, 3)
+	second := parseNumber(m, 4) + parseNumber(m, 5)
+	fraction := (m[6] + "000")[:3]
+	milli, _ := strconv.ParseUint(fraction, 10, 64)
+
+	return FromParams(negative, hour, minute, second, milli), nil
+}
@@ -0,0 +1,137 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_FormatFFmpeg(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "00:00:00.000"},
+		{timecode.Timecode(3723004), "01:02:03.004"},
+		{timecode.Timecode(-3723004), "-01:02:03.004"},
+		{100 * timecode.Hour, "100:00:00.000"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatFFmpeg()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatFFmpeg_IgnoresDefaultFormat(t *testing.T) {
+	// Setup fixture
+	original := timecode.DefaultFormat
+	defer func() { timecode.DefaultFormat = original }()
+	timecode.DefaultFormat = timecode.Timecode.FormatComma
+
+	// Exercise SUT
+	actual := timecode.Second.FormatFFmpeg()
+
+	// Verify result
+	assert.Equal(t, "00:00:01.000", actual)
+}
+
+func TestParseFFmpeg_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"00:00:00", timecode.Zero},
+		{"01:02:03.004", timecode.Timecode(3723004)},
+		{"-01:02:03.004", timecode.Timecode(-3723004)},
+		{"100:00:00.5", 100*timecode.Hour + 500*timecode.Millisecond},
+		{"02:03", 2*timecode.Minute + 3*timecode.Second},
+		{"0", timecode.Zero},
+		{"90", 90 * timecode.Second},
+		{"90.5", 90*timecode.Second + 500*timecode.Millisecond},
+		{"-90.05", -(90*timecode.Second + 50*timecode.Millisecond)},
+		{"0.0019", timecode.Millisecond},
+		{"3600.123456", timecode.Hour + 123*timecode.Millisecond},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFFmpeg(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseFFmpeg_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"",
+		"-",
+		"1.",
+		".5",
+		"1e3",
+		"1,5",
+		"00:60",
+		"00:00:60",
+		"1:00",
+		"00:00:00,000",
+		" 90",
+		"90s",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFFmpeg(test)
+
+			// Verify result
+			assert.EqualError(t, err, fmt.Sprintf("[%s] is not an ffmpeg duration", test))
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestParseFFmpeg_TooLarge(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"99999999999999999999",
+		"-99999999999999999999.5",
+		"99999999999999999999:00:00",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFFmpeg(test)
+
+			// Verify result
+			assert.EqualError(t, err, fmt.Sprintf("[%s] has a value which is too large", test))
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestFFmpeg_RoundTrip(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-3723004)
+
+	// Exercise SUT
+	actual, err := timecode.ParseFFmpeg(sut.FormatFFmpeg())
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, sut, actual)
+}
@@ -0,0 +1,28 @@
+package timecode
+
+import (
+	"flag"
+)
+
+// TimecodeFlag defines a Timecode flag with the specified name, default value,
+// and usage string on flag.CommandLine. The return value is the address of a
+// Timecode variable that stores the value of the flag. It mirrors
+// flag.Duration.
+func TimecodeFlag(name string, value Timecode, usage string) *Timecode {
+	p := new(Timecode)
+	*p = value
+	flag.Var(p, name, usage)
+	return p
+}
+
+// MarshalFlag formats t as per FormatDot, so that Timecode may be used with
+// flag libraries such as github.com/jessevdk/go-flags.
+func (t Timecode) MarshalFlag() (string, error) {
+	return t.FormatDot(), nil
+}
+
+// UnmarshalFlag parses str into t as per Set, so that *Timecode may be used
+// with flag libraries such as github.com/jessevdk/go-flags.
+func (t *Timecode) UnmarshalFlag(str string) error {
+	return t.Set(str)
+}
@@ -0,0 +1,70 @@
+package timecode_test
+
+import (
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecodeFlag(t *testing.T) {
+	// Setup fixture
+	original := flag.CommandLine
+	defer func() { flag.CommandLine = original }()
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+	flag.CommandLine.SetOutput(ioutil.Discard)
+
+	// Exercise SUT
+	start := timecode.TimecodeFlag("start", timecode.Minute, "usage")
+	end := timecode.TimecodeFlag("end", timecode.Hour, "usage")
+	err := flag.CommandLine.Parse([]string{"-start", "00:00:01.500"})
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, 1500*timecode.Millisecond, *start)
+	assert.Equal(t, timecode.Hour, *end)
+	assert.Equal(t, "00:01:00.000", flag.Lookup("start").DefValue)
+}
+
+func TestTimecodeFlag_InvalidCase(t *testing.T) {
+	// Setup fixture
+	original := flag.CommandLine
+	defer func() { flag.CommandLine = original }()
+	flag.CommandLine = flag.NewFlagSet("test", flag.ContinueOnError)
+	flag.CommandLine.SetOutput(ioutil.Discard)
+	start := timecode.TimecodeFlag("start", timecode.Minute, "usage")
+
+	// Exercise SUT
+	err := flag.CommandLine.Parse([]string{"-start", "not.a.timecode"})
+
+	// Verify result
+	assert.Error(t, err)
+	assert.Equal(t, timecode.Minute, *start)
+}
+
+func TestTimecode_MarshalFlag(t *testing.T) {
+	// Exercise SUT
+	actual, err := timecode.Timecode(-3723456).MarshalFlag()
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, "-01:02:03.456", actual)
+}
+
+func TestTimecode_UnmarshalFlag(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Minute
+
+	// Exercise SUT
+	validErr := sut.UnmarshalFlag("-01:02:03.456")
+	valid := sut
+	invalidErr := sut.UnmarshalFlag("not.a.timecode")
+
+	// Verify result
+	assert.NoError(t, validErr)
+	assert.Equal(t, timecode.Timecode(-3723456), valid)
+	assert.Error(t, invalidErr)
+	assert.Equal(t, timecode.Timecode(-3723456), sut)
+}
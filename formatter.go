@@ -0,0 +1,49 @@
+package timecode
+
+import (
+	"fmt"
+)
+
+// Formatter holds a reusable formatting configuration, so that it can be
+// passed through a program rather than repeating arguments at each call site.
+// The zero value formats as e.g. "01:02:03".
+type Formatter struct {
+	// WithMilli includes the milliseconds.
+	WithMilli bool
+	// MilliSeparator separates the seconds from the milliseconds. An empty
+	// MilliSeparator is taken to be a dot.
+	MilliSeparator string
+	// HoursWidth is the minimum number of digits for the hours, which is never
+	// fewer than 2.
+	HoursWidth int
+	// AlwaysShowSign prefixes positive Timecodes with "+", as with
+	// FormatWithSign.
+	AlwaysShowSign bool
+}
+
+// Check we implement the interface
+var _ fmt.Stringer = Formatter{}
+
+// Format formats t according to f's configuration.
+func (f Formatter) Format(t Timecode) string {
+	hoursWidth := f.HoursWidth
+	if hoursWidth < 2 {
+		hoursWidth = 2
+	}
+	sep := f.MilliSeparator
+	if sep == "" {
+		sep = "."
+	}
+
+	b := make([]byte, 0, 16)
+	if f.AlwaysShowSign && t > Zero {
+		b = append(b, '+')
+	}
+	return string(t.appendFormat(b, hoursWidth, f.WithMilli, sep))
+}
+
+// String describes f's configuration, e.g. for logging.
+func (f Formatter) String() string {
+	return fmt.Sprintf("Formatter{WithMilli: %t, MilliSeparator: %q, HoursWidth: %d, AlwaysShowSign: %t}",
+		f.WithMilli, f.MilliSeparator, f.HoursWidth, f.AlwaysShowSign)
+}
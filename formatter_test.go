@@ -0,0 +1,59 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatter_Format(t *testing.T) {
+	// Setup fixture
+	tc := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+
+	// Setup expectations
+	var tests = []struct {
+		formatter timecode.Formatter
+		timecode  timecode.Timecode
+		expected  string
+	}{
+		{timecode.Formatter{}, tc, "01:02:03"},
+		{timecode.Formatter{}, -tc, "-01:02:03"},
+		{timecode.Formatter{WithMilli: true}, tc, "01:02:03.004"},
+		{timecode.Formatter{WithMilli: true, MilliSeparator: ","}, tc, "01:02:03,004"},
+		{timecode.Formatter{MilliSeparator: ","}, tc, "01:02:03"},
+		{timecode.Formatter{HoursWidth: 1}, tc, "01:02:03"},
+		{timecode.Formatter{HoursWidth: 3}, tc, "001:02:03"},
+		{timecode.Formatter{HoursWidth: 3}, 100 * timecode.Hour, "100:00:00"},
+		{timecode.Formatter{AlwaysShowSign: true}, tc, "+01:02:03"},
+		{timecode.Formatter{AlwaysShowSign: true}, -tc, "-01:02:03"},
+		{timecode.Formatter{AlwaysShowSign: true}, timecode.Zero, "00:00:00"},
+		{
+			timecode.Formatter{WithMilli: true, MilliSeparator: ",", HoursWidth: 3, AlwaysShowSign: true},
+			tc,
+			"+001:02:03,004",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.formatter.Format(test.timecode)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFormatter_String(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Formatter{WithMilli: true, MilliSeparator: ",", HoursWidth: 3, AlwaysShowSign: true}
+
+	// Exercise SUT
+	actual := sut.String()
+
+	// Verify result
+	assert.Equal(t, `Formatter{WithMilli: true, MilliSeparator: ",", HoursWidth: 3, AlwaysShowSign: true}`, actual)
+}
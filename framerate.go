@@ -0,0 +1,104 @@
+package timecode
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// FrameRate is an exact, rational number of frames per second, e.g.
+// 30000/1001 for NTSC's 29.97. The zero value is not a valid FrameRate; use
+// NewFrameRate or one of the standard rates.
+type FrameRate struct {
+	numerator   uint32
+	denominator uint32
+}
+
+// Standard broadcast and film frame rates. These are unexported so that they
+// cannot be reassigned, and are instead returned by the functions below.
+var (
+	film24    = FrameRate{24, 1}
+	film23976 = FrameRate{24000, 1001}
+	pal25     = FrameRate{25, 1}
+	ntsc30    = FrameRate{30, 1}
+	ntsc2997  = FrameRate{30000, 1001}
+	pal50     = FrameRate{50, 1}
+	ntsc60    = FrameRate{60, 1}
+	ntsc5994  = FrameRate{60000, 1001}
+)
+
+// Film24 returns the 24 fps film frame rate, 24/1.
+func Film24() FrameRate { return film24 }
+
+// Film23976 returns the 23.976 fps film frame rate, 24000/1001.
+func Film23976() FrameRate { return film23976 }
+
+// PAL25 returns the 25 fps PAL frame rate, 25/1.
+func PAL25() FrameRate { return pal25 }
+
+// NTSC30 returns the 30 fps NTSC frame rate, 30/1.
+func NTSC30() FrameRate { return ntsc30 }
+
+// NTSC2997 returns the 29.97 fps NTSC frame rate, 30000/1001.
+func NTSC2997() FrameRate { return ntsc2997 }
+
+// PAL50 returns the 50 fps PAL frame rate, 50/1.
+func PAL50() FrameRate { return pal50 }
+
+// NTSC60 returns the 60 fps NTSC frame rate, 60/1.
+func NTSC60() FrameRate { return ntsc60 }
+
+// NTSC5994 returns the 59.94 fps NTSC frame rate, 60000/1001.
+func NTSC5994() FrameRate { return ntsc5994 }
+
+// Check we implement the interface
+var _ fmt.Stringer = film24
+
+// NewFrameRate constructs a FrameRate of numerator/denominator frames per
+// second. An error is returned if either is zero.
+func NewFrameRate(numerator, denominator uint32) (FrameRate, error) {
+	if numerator == 0 || denominator == 0 {
+		return FrameRate{}, fmt.Errorf("[%d/%d] is not a valid frame rate", numerator, denominator)
+	}
+	return FrameRate{numerator, denominator}, nil
+}
+
+// FramesPerSecond returns the numerator and denominator of r.
+func (r FrameRate) FramesPerSecond() (num, den uint32) {
+	return r.numerator, r.denominator
+}
+
+// Float64 returns r as a (possibly inexact) number of frames per second, e.g.
+// 29.97002997 for NTSC2997(). The zero value gives 0.
+func (r FrameRate) Float64() float64 {
+	if r.denominator == 0 {
+		return 0
+	}
+	return float64(r.numerator) / float64(r.denominator)
+}
+
+// FrameDuration returns the duration of a single frame, rounded to the nearest
+// millisecond, e.g. 40ms for PAL25() and 33ms for NTSC2997(). The zero value
+// gives Zero.
+func (r FrameRate) FrameDuration() Timecode {
+	if r.numerator == 0 {
+		return Zero
+	}
+	return Timecode(math.Round(1000 * float64(r.denominator) / float64(r.numerator)))
+}
+
+// String formats r as frames per second to at most 3 decimal places, e.g.
+// "25" or "29.97".
+func (r FrameRate) String() string {
+	return strconv.FormatFloat(math.Round(r.Float64()*1000)/1000, 'f', -1, 64)
+}
+
+// FormatFramesAt is like FormatFrames, but takes a FrameRate.
+func (t Timecode) FormatFramesAt(r FrameRate) string {
+	return t.FormatFrames(r.Float64())
+}
+
+// ParseFramesAt is like ParseFrames, but takes a FrameRate.
+func ParseFramesAt(str string, r FrameRate) (Timecode, error) {
+	return ParseFrames(str, r.Float64())
+}
@@ -0,0 +1,120 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFrameRate_ValidCase(t *testing.T) {
+	// Exercise SUT
+	actual, err := timecode.NewFrameRate(30000, 1001)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, timecode.NTSC2997(), actual)
+}
+
+func TestNewFrameRate_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		num uint32
+		den uint32
+	}{
+		{0, 1},
+		{25, 0},
+		{0, 0},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.NewFrameRate(test.num, test.den)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.FrameRate{}, actual)
+		})
+	}
+}
+
+func TestFrameRate(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r                     timecode.FrameRate
+		expectedNum           uint32
+		expectedDen           uint32
+		expectedFloat         float64
+		expectedFrameDuration timecode.Timecode
+		expectedString        string
+	}{
+		{timecode.Film24(), 24, 1, 24, 42, "24"},
+		{timecode.Film23976(), 24000, 1001, 24000.0 / 1001.0, 42, "23.976"},
+		{timecode.PAL25(), 25, 1, 25, 40, "25"},
+		{timecode.NTSC30(), 30, 1, 30, 33, "30"},
+		{timecode.NTSC2997(), 30000, 1001, 30000.0 / 1001.0, 33, "29.97"},
+		{timecode.PAL50(), 50, 1, 50, 20, "50"},
+		{timecode.NTSC60(), 60, 1, 60, 17, "60"},
+		{timecode.NTSC5994(), 60000, 1001, 60000.0 / 1001.0, 17, "59.94"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualNum, actualDen := test.r.FramesPerSecond()
+			actualFloat := test.r.Float64()
+			actualFrameDuration := test.r.FrameDuration()
+			actualString := test.r.String()
+
+			// Verify result
+			assert.Equal(t, test.expectedNum, actualNum)
+			assert.Equal(t, test.expectedDen, actualDen)
+			assert.Equal(t, test.expectedFloat, actualFloat)
+			assert.Equal(t, test.expectedFrameDuration, actualFrameDuration)
+			assert.Equal(t, test.expectedString, actualString)
+		})
+	}
+}
+
+func TestFrameRate_ZeroValue(t *testing.T) {
+	// Setup fixture
+	var sut timecode.FrameRate
+
+	// Exercise SUT
+	actualFloat := sut.Float64()
+	actualFrameDuration := sut.FrameDuration()
+
+	// Verify result
+	assert.Equal(t, 0.0, actualFloat)
+	assert.Equal(t, timecode.Zero, actualFrameDuration)
+}
+
+func TestTimecode_FormatFramesAt(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(3723480)
+
+	// Exercise SUT
+	actual := sut.FormatFramesAt(timecode.PAL25())
+
+	// Verify result
+	assert.Equal(t, "01:02:03:12", actual)
+}
+
+func TestParseFramesAt(t *testing.T) {
+	// Exercise SUT
+	actual, err := timecode.ParseFramesAt("00:00:01:00", timecode.Film23976())
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, 1001*timecode.Millisecond, actual)
+}
+
+func TestParseFramesAt_ZeroValue(t *testing.T) {
+	// Exercise SUT
+	_, err := timecode.ParseFramesAt("00:00:01:00", timecode.FrameRate{})
+
+	// Verify result
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package timecode
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+// framesRegex matches an SMPTE style HH:MM:SS:FF timecode, capturing the sign
+// (optional) hours, minutes, seconds, and frames groups.
+var framesRegex = regexp.MustCompile(`^([-])?(\d{2,}):([012345]\d):([012345]\d):(\d{2,})$`)
+
+// FormatFrames formats t as an SMPTE style HH:MM:SS:FF timecode at the given
+// frames per second, where FF is the frame within the current second, e.g.
+// "01:02:03:12".
+//
+// t is first rounded to the nearest frame. For non-integer frame rates (e.g.
+// 23.976), frames are counted at the nearest integer rate (e.g. 24) as with
+// non-drop-frame timecode, so that FF is always below it. It panics if fps is
+// not positive.
+func (t Timecode) FormatFrames(fps float64) string {
+	nominal := nominalFPS(fps)
+	frames := t.ToFrame(fps)
+	negative := frames < 0
+	if negative {
+		frames *= -1
+	}
+
+	ff := frames % nominal
+	totalSeconds := frames / nominal
+	result := fmt.Sprintf("%02d:%02d:%02d:%02d",
+		totalSeconds/3600, (totalSeconds/60)%60, totalSeconds%60, ff)
+
+	if negative {
+		return "-" + result
+	}
+	return result
+}
+
+// ParseFrames extracts a Timecode from an SMPTE style HH:MM:SS:FF timecode at
+// the given frames per second. It is the inverse of FormatFrames, rounding to
+// the nearest millisecond. An error is returned if str is not of that form,
+// if FF is not a valid frame for fps, if HH does not fit in 32 bits, or if
+// fps is not positive.
+func ParseFrames(str string, fps float64) (Timecode, error) {
+	if !isValidFPS(fps) {
+		return Zero, fmt.Errorf("[%v] is not a valid frame rate", fps)
+	}
+	m := framesRegex.FindStringSubmatch(str)
+	if len(m) == 0 {
+		return Zero, fmt.Errorf("[%s] is not a frame timecode", str)
+	}
+
+	if _, err := strconv.ParseUint(m[2], 10, 32); err != nil {
+		return Zero, fmt.Errorf("[%s] has too many hours", str)
+	}
+
+	nominal := nominalFPS(fps)
+	hour := parseNumber(m, 2)
+	minute := parseNumber(m, 3)
+	second := parseNumber(m, 4)
+	ff, _ := strconv.ParseInt(m[5], 10, 64)
+	if ff >= nominal {
+		return Zero, fmt.Errorf("[%s] has frame [%d], but there are only [%d] frames per second", str, ff, nominal)
+	}
+
+	frames := int64((hour*60+minute)*60+second)*nominal + ff
+	result := FromFrame(frames, fps)
+	if isNotEmpty(m, 1) {
+		return result.Negate(), nil
+	}
+	return result, nil
+}
+
+// ToFrame returns the zero-based index of the frame nearest to t at the given
+// frames per second, e.g. 1000ms at 25 fps is frame 25. Negative Timecodes
+// give negative frames. It panics if fps is not positive.
+func (t Timecode) ToFrame(fps float64) int64 {
+	mustValidFPS(fps)
+	return int64(math.Round(float64(t) * fps / 1000))
+}
+
+// FromFrame returns the Timecode at which the zero-based frame starts at the
+// given frames per second, rounded to the nearest millisecond. E.g. frame 25
+// at 25 fps is 1000ms, so advancing 5 frames is
+// FromFrame(t.ToFrame(fps)+5, fps). Negative frames give negative Timecodes.
+// It panics if fps is not positive.
+func FromFrame(frame int64, fps float64) Timecode {
+	mustValidFPS(fps)
+	return Timecode(math.Round(float64(frame) * 1000 / fps))
+}
+
+// Quantize returns t snapped to the nearest frame boundary at the given frames
+// per second, rounded to the nearest millisecond. E.g. at 25 fps (40ms frames),
+// 1019ms gives 1000ms and 1020ms gives 1040ms. It panics if fps is not
+// positive.
+func (t Timecode) Quantize(fps float64) Timecode {
+	return FromFrame(t.ToFrame(fps), fps)
+}
+
+// FrameAccurate is true if t falls on a frame boundary at the given frames per
+// second, i.e. if Quantize would leave it unchanged. It panics if fps is not
+// positive.
+func (t Timecode) FrameAccurate(fps float64) bool {
+	return t.Quantize(fps) == t
+}
+
+func nominalFPS(fps float64) int64 {
+	mustValidFPS(fps)
+	return int64(math.Round(fps))
+}
+
+// isValidFPS is true if fps is a finite rate of at least one frame per second,
+// once rounded.
+func isValidFPS(fps float64) bool {
+	return fps >= 0.5 && !math.IsInf(fps, 0)
+}
+
+func mustValidFPS(fps float64) {
+	if !isValidFPS(fps) {
+		panic(fmt.Sprintf("timecode: [%v] is not a valid frame rate", fps))
+	}
+}
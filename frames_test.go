@@ -0,0 +1,239 @@
+package timecode_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_FormatFrames(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		fps      float64
+		expected string
+	}{
+		{timecode.Zero, 25, "00:00:00:00"},
+		{40 * timecode.Millisecond, 25, "00:00:00:01"},
+		{960 * timecode.Millisecond, 25, "00:00:00:24"},
+		{timecode.Second, 25, "00:00:01:00"},
+		{timecode.Timecode(3723480), 25, "01:02:03:12"},
+		{timecode.Timecode(-3723480), 25, "-01:02:03:12"},
+		{19 * timecode.Millisecond, 25, "00:00:00:00"},
+		{20 * timecode.Millisecond, 25, "00:00:00:01"},
+		{990 * timecode.Millisecond, 25, "00:00:01:00"},
+		{500 * timecode.Millisecond, 30, "00:00:00:15"},
+		{980 * timecode.Millisecond, 23.976, "00:00:00:23"},
+		{1001 * timecode.Millisecond, 23.976, "00:00:01:00"},
+		{timecode.Hour, 24, "01:00:00:00"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatFrames(test.fps)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatFrames_InvalidFPS(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { timecode.Second.FormatFrames(0) })
+	assert.Panics(t, func() { timecode.Second.FormatFrames(-25) })
+}
+
+func TestParseFrames_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		fps      float64
+		expected timecode.Timecode
+	}{
+		{"00:00:00:00", 25, timecode.Zero},
+		{"00:00:00:01", 25, 40 * timecode.Millisecond},
+		{"00:00:00:24", 25, 960 * timecode.Millisecond},
+		{"01:02:03:12", 25, timecode.Timecode(3723480)},
+		{"-01:02:03:12", 25, timecode.Timecode(-3723480)},
+		{"00:00:00:15", 30, 500 * timecode.Millisecond},
+		{"00:00:00:01", 30, 33 * timecode.Millisecond},
+		{"00:00:01:00", 23.976, 1001 * timecode.Millisecond},
+		{"100:00:00:00", 25, 100 * timecode.Hour},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFrames(test.str, test.fps)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseFrames_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str string
+		fps float64
+	}{
+		{"", 25},
+		{"not.a.timecode", 25},
+		{"00:00:00", 25},
+		{"00:00:00.000", 25},
+		{"00:00:00:25", 25},
+		{"00:00:00:24", 23.976},
+		{"00:60:00:00", 25},
+		{"00:00:00:00 trailing", 25},
+		{"00:00:00:00", 0},
+		{"00:00:00:00", -25},
+		{"00:00:00:00", 0.3},
+		{"99999999999999999999:00:00:00", 25},
+		{"9999999999999:00:00:00", 25},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFrames(test.str, test.fps)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestFrames_RoundTrip(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str string
+		fps float64
+	}{
+		{"01:02:03:12", 25},
+		{"23:59:59:29", 29.97},
+		{"00:10:00:23", 23.976},
+		{"-00:00:05:59", 60},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			tc, err := timecode.ParseFrames(test.str, test.fps)
+			actual := tc.FormatFrames(test.fps)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.str, actual)
+		})
+	}
+}
+
+func TestTimecode_Quantize(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode         timecode.Timecode
+		fps              float64
+		expected         timecode.Timecode
+		expectedAccurate bool
+	}{
+		{timecode.Zero, 25, timecode.Zero, true},
+		{timecode.Second, 25, timecode.Second, true},
+		{1019 * timecode.Millisecond, 25, timecode.Second, false},
+		{1020 * timecode.Millisecond, 25, 1040 * timecode.Millisecond, false},
+		{1040 * timecode.Millisecond, 25, 1040 * timecode.Millisecond, true},
+		{-1019 * timecode.Millisecond, 25, -timecode.Second, false},
+		{-1040 * timecode.Millisecond, 25, -1040 * timecode.Millisecond, true},
+		{50 * timecode.Millisecond, 30, 67 * timecode.Millisecond, false},
+		{33 * timecode.Millisecond, 30, 33 * timecode.Millisecond, true},
+		{1001 * timecode.Millisecond, 23.976, 1001 * timecode.Millisecond, true},
+		{timecode.Second, 23.976, 1001 * timecode.Millisecond, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Quantize(test.fps)
+			actualAccurate := test.timecode.FrameAccurate(test.fps)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expectedAccurate, actualAccurate)
+			assert.True(t, actual.FrameAccurate(test.fps))
+		})
+	}
+}
+
+func TestTimecode_Quantize_InvalidFPS(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { timecode.Second.Quantize(0) })
+	assert.Panics(t, func() { timecode.Second.FrameAccurate(-1) })
+}
+
+func TestTimecode_ToFrame(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		fps      float64
+		expected int64
+	}{
+		{timecode.Zero, 25, 0},
+		{timecode.Second, 25, 25},
+		{1019 * timecode.Millisecond, 25, 25},
+		{1020 * timecode.Millisecond, 25, 26},
+		{-timecode.Second, 25, -25},
+		{timecode.Hour, 30, 108000},
+		{1001 * timecode.Millisecond, 23.976, 24},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.ToFrame(test.fps)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFromFrame(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		frame    int64
+		fps      float64
+		expected timecode.Timecode
+	}{
+		{0, 25, timecode.Zero},
+		{25, 25, timecode.Second},
+		{30, 25, 1200 * timecode.Millisecond},
+		{-5, 25, -200 * timecode.Millisecond},
+		{1, 30, 33 * timecode.Millisecond},
+		{2, 30, 67 * timecode.Millisecond},
+		{24, 23.976, 1001 * timecode.Millisecond},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.FromFrame(test.frame, test.fps)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.frame, actual.ToFrame(test.fps))
+		})
+	}
+}
+
+func TestToFrameFromFrame_InvalidFPS(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.Panics(t, func() { timecode.Second.ToFrame(0) })
+	assert.Panics(t, func() { timecode.FromFrame(1, math.Inf(1)) })
+}
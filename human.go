@@ -0,0 +1,137 @@
+package timecode
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var unitTokenRegex = regexp.MustCompile(`^(\d+)([a-z]*)$`)
+var compactTokenRegex = regexp.MustCompile(`\d+[a-z]*`)
+
+var unitsBySuffix = map[string]Timecode{
+	"h":  Hour,
+	"m":  Minute,
+	"s":  Second,
+	"ms": Millisecond,
+}
+
+// FormatHuman formats t as a compact English duration, e.g. "1h 2m 3s 4ms".
+// Zero components are omitted, so Second gives "1s", and Zero itself is "0s".
+// Negative Timecodes are prefixed with "-".
+func (t Timecode) FormatHuman() string {
+	return t.formatUnits(" ")
+}
+
+// ParseHuman extracts a Timecode from the FormatHuman format, e.g.
+// "1h 2m 3s 4ms". Components are separated by whitespace, may appear in any
+// order, and may be omitted, but each unit may only appear once.
+func ParseHuman(str string) (Timecode, error) {
+	negative, rest := cutSign(strings.TrimSpace(str))
+	t, err := parseUnitTokens(strings.Fields(rest))
+	if err != nil {
+		return Zero, fmt.Errorf("[%s] is not a human duration: %v", str, err)
+	}
+	if negative {
+		return t.Negate(), nil
+	}
+	return t, nil
+}
+
+// FormatCompact formats t like FormatHuman, but without spaces, e.g.
+// "1h2m3s4ms" or "1h3s". This is suitable for URL parameters and CLI flags.
+func (t Timecode) FormatCompact() string {
+	return t.formatUnits("")
+}
+
+// ParseCompact extracts a Timecode from the FormatCompact format, e.g.
+// "1h2m3s4ms". Components may appear in any order, and may be omitted, but
+// each unit may only appear once. Unknown units return an error.
+func ParseCompact(str string) (Timecode, error) {
+	negative, rest := cutSign(str)
+	tokens := compactTokenRegex.FindAllString(rest, -1)
+	if strings.Join(tokens, "") != rest {
+		return Zero, fmt.Errorf("[%s] is not a compact duration", str)
+	}
+	t, err := parseUnitTokens(tokens)
+	if err != nil {
+		return Zero, fmt.Errorf("[%s] is not a compact duration: %v", str, err)
+	}
+	if negative {
+		return t.Negate(), nil
+	}
+	return t, nil
+}
+
+// FormatAdaptive formats t in the shortest unambiguous way, e.g. "4ms", "3s",
+// or "1m3s" (as per FormatCompact), but switches to FormatDot (e.g.
+// "01:02:03.004") when the hours, minutes, seconds, and milliseconds are all
+// non-zero.
+func (t Timecode) FormatAdaptive() string {
+	h, m, s, ms := t.HourMinuteSecondMilli()
+	if h != 0 && m != 0 && s != 0 && ms != 0 {
+		return t.FormatDot()
+	}
+	return t.FormatCompact()
+}
+
+func (t Timecode) formatUnits(sep string) string {
+	if t == Zero {
+		return "0s"
+	}
+	h, m, s, ms := t.HourMinuteSecondMilli()
+
+	var parts []string
+	for _, c := range []struct {
+		value  uint64
+		suffix string
+	}{{h, "h"}, {m, "m"}, {s, "s"}, {ms, "ms"}} {
+		if c.value != 0 {
+			parts = append(parts, strconv.FormatUint(c.value, 10)+c.suffix)
+		}
+	}
+
+	result := strings.Join(parts, sep)
+	if t.IsNegative() {
+		return "-" + result
+	}
+	return result
+}
+
+func parseUnitTokens(tokens []string) (Timecode, error) {
+	if len(tokens) == 0 {
+		return Zero, fmt.Errorf("no components")
+	}
+
+	total := Zero
+	seen := make(map[string]bool, len(tokens))
+	for _, token := range tokens {
+		m := unitTokenRegex.FindStringSubmatch(token)
+		if len(m) == 0 {
+			return Zero, fmt.Errorf("[%s] is not a component", token)
+		}
+		unit, ok := unitsBySuffix[m[2]]
+		if !ok {
+			return Zero, fmt.Errorf("[%s] is not a known unit", m[2])
+		}
+		if seen[m[2]] {
+			return Zero, fmt.Errorf("[%s] appears more than once", m[2])
+		}
+		seen[m[2]] = true
+
+		value, err := strconv.ParseUint(m[1], 10, 32)
+		if err != nil {
+			return Zero, err
+		}
+		total += Timecode(value) * unit
+	}
+	return total, nil
+}
+
+func cutSign(str string) (bool, string) {
+	if strings.HasPrefix(str, "-") {
+		return true, str[1:]
+	}
+	return false, str
+}
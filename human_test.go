@@ -0,0 +1,200 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_FormatHuman(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "0s"},
+		{timecode.Millisecond, "1ms"},
+		{timecode.Second, "1s"},
+		{timecode.Minute, "1m"},
+		{timecode.Hour, "1h"},
+		{timecode.Hour + 3*timecode.Second, "1h 3s"},
+		{timecode.Hour + 2*timecode.Minute + 3*timecode.Second + 4*timecode.Millisecond, "1h 2m 3s 4ms"},
+		{-(timecode.Hour + 4*timecode.Millisecond), "-1h 4ms"},
+		{100 * timecode.Hour, "100h"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatHuman()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseHuman_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"0s", timecode.Zero},
+		{"1ms", timecode.Millisecond},
+		{"1h 3s", timecode.Hour + 3*timecode.Second},
+		{"1h 2m 3s 4ms", timecode.Timecode(3723004)},
+		{"4ms 3s 2m 1h", timecode.Timecode(3723004)},
+		{"  1h   2m\t3s ", timecode.Hour + 2*timecode.Minute + 3*timecode.Second},
+		{"-1h 4ms", -(timecode.Hour + 4*timecode.Millisecond)},
+		{"90m", 90 * timecode.Minute},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseHuman(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseHuman_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"",
+		"-",
+		"1",
+		"1x",
+		"1h2m",
+		"1h 1h",
+		"h",
+		"1.5s",
+		"1 h",
+		"-1h -2m",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseHuman(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatCompact(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "0s"},
+		{timecode.Millisecond, "1ms"},
+		{timecode.Hour + 3*timecode.Second, "1h3s"},
+		{timecode.Hour + 2*timecode.Minute + 3*timecode.Second + 4*timecode.Millisecond, "1h2m3s4ms"},
+		{-(timecode.Minute + 4*timecode.Millisecond), "-1m4ms"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatCompact()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatAdaptive(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "0s"},
+		{4 * timecode.Millisecond, "4ms"},
+		{3 * timecode.Second, "3s"},
+		{timecode.Minute + 3*timecode.Second, "1m3s"},
+		{timecode.Hour + 2*timecode.Minute + 3*timecode.Second, "1h2m3s"},
+		{timecode.Hour + 2*timecode.Minute + 3*timecode.Second + 4*timecode.Millisecond, "01:02:03.004"},
+		{-(timecode.Hour + 2*timecode.Minute + 3*timecode.Second + 4*timecode.Millisecond), "-01:02:03.004"},
+		{-(timecode.Minute + 4*timecode.Millisecond), "-1m4ms"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatAdaptive()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseCompact_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"0s", timecode.Zero},
+		{"1ms", timecode.Millisecond},
+		{"1h3s", timecode.Hour + 3*timecode.Second},
+		{"1h2m3s4ms", timecode.Timecode(3723004)},
+		{"4ms3s2m1h", timecode.Timecode(3723004)},
+		{"3s1h", timecode.Hour + 3*timecode.Second},
+		{"-1m4ms", -(timecode.Minute + 4*timecode.Millisecond)},
+		{"1500ms", 1500 * timecode.Millisecond},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseCompact(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseCompact_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"",
+		"-",
+		"1",
+		"1h2",
+		"1d",
+		"1h2x",
+		"1h 2m",
+		"1h1h",
+		"h1",
+		"1.5s",
+		"--1s",
+		"1s-",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseCompact(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
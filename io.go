@@ -0,0 +1,94 @@
+package timecode
+
+import (
+	"io"
+)
+
+// Check we implement the interfaces. Note that unlike the io.ReaderFrom
+// convention, ReadFrom returns io.EOF if r holds no timecode, so that the end
+// of a stream of timecodes can be detected. This means io.Copy will report an
+// error for an empty r.
+var _ io.WriterTo = Zero
+var _ io.ReaderFrom = (*Timecode)(nil)
+
+// WriteTo writes t to w as per FormatDot, returning the number of bytes
+// written. Unlike String, this does not follow DefaultFormat, so that what is
+// written may always be read back with ReadFrom.
+func (t Timecode) WriteTo(w io.Writer) (int64, error) {
+	var buf [24]byte
+	n, err := w.Write(t.AppendFormat(buf[:0], true, "."))
+	return int64(n), err
+}
+
+// ReadFrom reads from r up to the end of the first timecode (as per Parse),
+// and parses it into t. Anything before the timecode is skipped. r is read one
+// byte at a time, and reading stops as soon as the timecode cannot be made any
+// longer, so that several timecodes may be read in turn, e.g. from
+// "00:00:01,000-->00:00:02,000". If the timecode could still have been longer
+// (e.g. if it lacks milliseconds), then the byte which ends it is consumed as
+// well, unless r is an io.ByteScanner which can unread it.
+//
+// io.EOF is returned if r holds nothing but whitespace. t is left unchanged
+// if an error is returned.
+func (t *Timecode) ReadFrom(r io.Reader) (int64, error) {
+	var n int64
+	var read, token []byte
+	b := make([]byte, 1)
+	for {
+		count, err := r.Read(b)
+		n += int64(count)
+		if count > 0 {
+			read = append(read, b[0])
+			next := append(token, b[0])
+			if !defaultGrammar.isPartial(string(next)) && defaultGrammar.isFull(string(token)) {
+				if s, ok := r.(io.ByteScanner); ok && s.UnreadByte() == nil {
+					n--
+				}
+				break
+			}
+			token = longestPartialSuffix(next)
+			if defaultGrammar.isFull(string(token)) && !defaultGrammar.isExtensible(string(token)) {
+				break
+			}
+		}
+		if err == io.EOF {
+			if defaultGrammar.isFull(string(token)) {
+				break
+			}
+			if isBlank(read) {
+				return n, io.EOF
+			}
+			return n, newParseError(string(read), defaultGrammar)
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+
+	*t = fromRegexMatch(Regex.FindStringSubmatch(string(token)))
+	return n, nil
+}
+
+// longestPartialSuffix returns the longest suffix of b which is a partial
+// match of Regex, so that a match may start partway through what was read.
+func longestPartialSuffix(b []byte) []byte {
+	for i := range b {
+		if defaultGrammar.isPartial(string(b[i:])) {
+			return b[i:]
+		}
+	}
+	return b[len(b):]
+}
+
+// isBlank is true if b holds only ASCII whitespace. Other bytes are not
+// checked as runes, since b may end partway through one.
+func isBlank(b []byte) bool {
+	for _, c := range b {
+		switch c {
+		case ' ', '\t', '\n', '\v', '\f', '\r':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+package timecode_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_WriteTo(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-3723456)
+	var buf bytes.Buffer
+
+	// Exercise SUT
+	n, err := sut.WriteTo(&buf)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, int64(13), n)
+	assert.Equal(t, "-01:02:03.456", buf.String())
+}
+
+func TestTimecode_WriteTo_IgnoresDefaultFormat(t *testing.T) {
+	// Setup fixture
+	original := timecode.DefaultFormat
+	defer func() { timecode.DefaultFormat = original }()
+	timecode.DefaultFormat = timecode.Timecode.FormatHuman
+	sut := timecode.Timecode(3723456)
+	var buf bytes.Buffer
+	var actual timecode.Timecode
+
+	// Exercise SUT
+	_, writeErr := sut.WriteTo(&buf)
+	_, readErr := actual.ReadFrom(&buf)
+
+	// Verify result
+	assert.NoError(t, writeErr)
+	assert.NoError(t, readErr)
+	assert.Equal(t, sut, actual)
+}
+
+func TestTimecode_WriteTo_WriterError(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Second
+
+	// Exercise SUT
+	_, err := sut.WriteTo(failingWriter{})
+
+	// Verify result
+	assert.Error(t, err)
+}
+
+func TestTimecode_ReadFrom_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		input    string
+		expected timecode.Timecode
+		n        int64
+	}{
+		{"01:02:03.456", timecode.Timecode(3723456), 12},
+		{"-01:02:03,456", timecode.Timecode(-3723456), 13},
+		{"  \n00:00:01\n", timecode.Second, 11},
+		{"00:00:01.000 ignored", timecode.Second, 12},
+		{"foo 00:00:01.000", timecode.Second, 16},
+		{"123:00:00.000", 23 * timecode.Hour, 13},
+		{"à 00:00:01à", timecode.Second, 11},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			var sut timecode.Timecode
+
+			// Exercise SUT
+			n, err := sut.ReadFrom(strings.NewReader(test.input))
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.n, n)
+			assert.Equal(t, test.expected, sut)
+		})
+	}
+}
+
+func TestTimecode_ReadFrom_MultipleTimecodes(t *testing.T) {
+	// Setup fixture
+	r := strings.NewReader("00:00:01.000 00:00:02.000\n")
+	var first, second, third timecode.Timecode
+
+	// Exercise SUT
+	_, err1 := first.ReadFrom(r)
+	_, err2 := second.ReadFrom(r)
+	_, err3 := third.ReadFrom(r)
+
+	// Verify result
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, io.EOF, err3)
+	assert.Equal(t, timecode.Second, first)
+	assert.Equal(t, 2*timecode.Second, second)
+	assert.Equal(t, timecode.Zero, third)
+}
+
+func TestTimecode_ReadFrom_NoWhitespace(t *testing.T) {
+	// Setup fixture
+	r := iotest.OneByteReader(strings.NewReader("00:00:01,000-->00:00:02,000"))
+	var first, second timecode.Timecode
+
+	// Exercise SUT
+	n1, err1 := first.ReadFrom(r)
+	n2, err2 := second.ReadFrom(r)
+
+	// Verify result
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, int64(12), n1)
+	assert.Equal(t, int64(15), n2)
+	assert.Equal(t, timecode.Second, first)
+	assert.Equal(t, 2*timecode.Second, second)
+}
+
+func TestTimecode_ReadFrom_UnreadsDelimiter(t *testing.T) {
+	// Setup fixture
+	r := strings.NewReader("00:00:01-00:00:02")
+	var first, second timecode.Timecode
+
+	// Exercise SUT
+	n1, err1 := first.ReadFrom(r)
+	_, err2 := second.ReadFrom(r)
+
+	// Verify result
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, int64(8), n1)
+	assert.Equal(t, timecode.Second, first)
+	assert.Equal(t, -2*timecode.Second, second)
+}
+
+func TestTimecode_ReadFrom_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		input    string
+		expected error
+	}{
+		{"", io.EOF},
+		{" \t\n", io.EOF},
+		{"not.a.timecode", nil},
+		{"\u00e0", nil},
+		{"00:00:0", nil},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Minute
+
+			// Exercise SUT
+			_, err := sut.ReadFrom(strings.NewReader(test.input))
+
+			// Verify result
+			assert.Error(t, err)
+			if test.expected != nil {
+				assert.Equal(t, test.expected, err)
+			}
+			assert.Equal(t, timecode.Minute, sut)
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
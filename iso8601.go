@@ -0,0 +1,101 @@
+package timecode
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// iso8601Regex matches the ISO 8601 duration grammar, capturing the sign
+// (an extension to ISO 8601), years, months, weeks, days, hours, minutes,
+// seconds, and fractional seconds.
+var iso8601Regex = regexp.MustCompile(
+	`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:[.,](\d+))?S)?)?$`)
+
+// FormatISO8601 formats t as an ISO 8601 duration, e.g. "PT1H2M3.456S". Zero
+// components are omitted, and Zero itself is "PT0S". Negative Timecodes are
+// prefixed with "-", e.g. "-PT1M".
+func (t Timecode) FormatISO8601() string {
+	if t == Zero {
+		return "PT0S"
+	}
+	h, m, s, ms := t.HourMinuteSecondMilli()
+
+	b := make([]byte, 0, 24)
+	if t.IsNegative() {
+		b = append(b, '-')
+	}
+	b = append(b, "PT"...)
+	if h != 0 {
+		b = strconv.AppendUint(b, h, 10)
+		b = append(b, 'H')
+	}
+	if m != 0 {
+		b = strconv.AppendUint(b, m, 10)
+		b = append(b, 'M')
+	}
+	if s != 0 || ms != 0 {
+		b = strconv.AppendUint(b, s, 10)
+		if ms != 0 {
+			b = append(b, '.')
+			b = append(b, strings.TrimRight(string(appendPadded(nil, ms, 3)), "0")...)
+		}
+		b = append(b, 'S')
+	}
+	return string(b)
+}
+
+// ParseISO8601 extracts a Timecode from an ISO 8601 duration, e.g.
+// "PT1H2M3.456S". Hours, minutes, and (fractional) seconds are supported, as
+// is a leading "-" for negative durations. Years, months, weeks, and days are
+// not, since their length is ambiguous, and will return an error. Fractions of
+// a second beyond milliseconds are truncated.
+func ParseISO8601(str string) (Timecode, error) {
+	return parseISO8601(str, false)
+}
+
+// ParseISO8601Duration is like ParseISO8601, but also accepts days, which are
+// taken to be exactly 24 hours, e.g. "P1DT2H". This is how durations are
+// interpreted in MPEG-DASH manifests and SMIL files, where they are not tied
+// to a calendar. Years, months, and weeks still return an error.
+func ParseISO8601Duration(str string) (Timecode, error) {
+	return parseISO8601(str, true)
+}
+
+func parseISO8601(str string, allowDays bool) (Timecode, error) {
+	m := iso8601Regex.FindStringSubmatch(str)
+	if len(m) == 0 || strings.HasSuffix(str, "P") || strings.HasSuffix(str, "T") {
+		return Zero, fmt.Errorf("[%s] is not an ISO 8601 duration", str)
+	}
+	if allowDays {
+		for i := 2; i <= 4; i++ {
+			if isNotEmpty(m, i) {
+				return Zero, fmt.Errorf("[%s] uses years, months, or weeks, which are not supported", str)
+			}
+		}
+	} else {
+		for i := 2; i <= 5; i++ {
+			if isNotEmpty(m, i) {
+				return Zero, fmt.Errorf("[%s] uses years, months, weeks, or days, which are not supported", str)
+			}
+		}
+	}
+
+	for i := 5; i <= 8; i++ {
+		if isNotEmpty(m, i) {
+			if _, err := strconv.ParseUint(m[i], 10, 32); err != nil {
+				return Zero, fmt.Errorf("[%s] has a value which is too large", str)
+			}
+		}
+	}
+
+	negative := isNotEmpty(m, 1)
+	hour := 24*parseNumber(m, 5) + parseNumber(m, 6)
+	minute := parseNumber(m, 7)
+	second := parseNumber(m, 8)
+	fraction := (m[9] + "000")[:3]
+	milli, _ := strconv.ParseUint(fraction, 10, 64)
+
+	return FromParams(negative, hour, minute, second, milli), nil
+}
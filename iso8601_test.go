@@ -0,0 +1,182 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_FormatISO8601(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "PT0S"},
+		{timecode.Millisecond, "PT0.001S"},
+		{timecode.Second, "PT1S"},
+		{timecode.Minute, "PT1M"},
+		{timecode.Hour, "PT1H"},
+		{timecode.Hour + 3*timecode.Second, "PT1H3S"},
+		{timecode.Timecode(3723456), "PT1H2M3.456S"},
+		{timecode.Timecode(3723400), "PT1H2M3.4S"},
+		{timecode.Timecode(-3723456), "-PT1H2M3.456S"},
+		{36 * timecode.Hour, "PT36H"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatISO8601()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseISO8601_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"PT0S", timecode.Zero},
+		{"PT0.001S", timecode.Millisecond},
+		{"PT1S", timecode.Second},
+		{"PT1M", timecode.Minute},
+		{"PT1H", timecode.Hour},
+		{"PT1H3S", timecode.Hour + 3*timecode.Second},
+		{"PT1H2M3.456S", timecode.Timecode(3723456)},
+		{"PT1H2M3,456S", timecode.Timecode(3723456)},
+		{"PT3.4S", 3400 * timecode.Millisecond},
+		{"PT3.4569S", 3456 * timecode.Millisecond},
+		{"PT90M", 90 * timecode.Minute},
+		{"-PT1H2M3.456S", timecode.Timecode(-3723456)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseISO8601(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseISO8601_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"",
+		"P",
+		"PT",
+		"1H",
+		"T1H",
+		"PT1S2M",
+		"PT1.5M",
+		"PT1X",
+		"P1Y",
+		"P1M",
+		"P1W",
+		"P1D",
+		"P1DT1H",
+		" PT1S",
+		"PT99999999999999999999H",
+		"PT99999999999999999999M",
+		"PT99999999999999999999.5S",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseISO8601(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestParseISO8601Duration_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"PT0S", timecode.Zero},
+		{"PT1.5S", 1500 * timecode.Millisecond},
+		{"PT1H30M5S", timecode.Hour + 30*timecode.Minute + 5*timecode.Second},
+		{"-PT2M", -2 * timecode.Minute},
+		{"P0D", timecode.Zero},
+		{"P1D", 24 * timecode.Hour},
+		{"P1DT2H", 26 * timecode.Hour},
+		{"-P2DT0.5S", -(48*timecode.Hour + 500*timecode.Millisecond)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseISO8601Duration(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseISO8601Duration_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected string
+	}{
+		{"", "[] is not an ISO 8601 duration"},
+		{"P1DT", "[P1DT] is not an ISO 8601 duration"},
+		{"1.5S", "[1.5S] is not an ISO 8601 duration"},
+		{"P1Y", "[P1Y] uses years, months, or weeks, which are not supported"},
+		{"P1M", "[P1M] uses years, months, or weeks, which are not supported"},
+		{"P1W", "[P1W] uses years, months, or weeks, which are not supported"},
+		{"P1Y2DT3H", "[P1Y2DT3H] uses years, months, or weeks, which are not supported"},
+		{"P99999999999999999999D", "[P99999999999999999999D] has a value which is too large"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseISO8601Duration(test.str)
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestISO8601_RoundTrip(t *testing.T) {
+	// Setup expectations
+	var tests = []timecode.Timecode{
+		timecode.Zero,
+		timecode.Millisecond,
+		timecode.Timecode(3723456),
+		timecode.Timecode(-3723456),
+		100 * timecode.Hour,
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseISO8601(test.FormatISO8601())
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test, actual)
+		})
+	}
+}
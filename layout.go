@@ -0,0 +1,92 @@
+package timecode
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// layoutVerbs maps each ParseCustomFormat verb to the pattern it matches, and
+// the index of the component it captures, as passed to ParseFromComponents.
+var layoutVerbs = map[byte]struct {
+	pattern   string
+	component int
+}{
+	'H': {`(\d+)`, 0},
+	'M': {`(\d{2})`, 1},
+	'S': {`(\d{2})`, 2},
+	'f': {`(\d{3})`, 3},
+}
+
+// ParseCustomFormat extracts a Timecode from str according to layout, in
+// which the following verbs stand for the components of the timecode:
+//
+//	%H  hours (one or more digits)
+//	%M  minutes (exactly 2 digits, below 60)
+//	%S  seconds (exactly 2 digits, below 60)
+//	%f  milliseconds (exactly 3 digits)
+//	%%  a literal "%"
+//
+// All other characters in layout must appear in str exactly. E.g.
+// ParseCustomFormat("03s02m01h", "%Ss%Mm%Hh"). Each verb may be used at most
+// once, and omitted components are taken to be zero. str must match layout
+// entirely, or an error is returned.
+func ParseCustomFormat(str, layout string) (Timecode, error) {
+	regex, components, err := compileLayout(layout)
+	if err != nil {
+		return Zero, fmt.Errorf("[%s] is not a valid layout: %v", layout, err)
+	}
+
+	m := regex.FindStringSubmatch(str)
+	if len(m) == 0 {
+		return Zero, fmt.Errorf("[%s] does not match layout [%s]", str, layout)
+	}
+	values := []string{"0", "0", "0", "0"}
+	for i, component := range components {
+		values[component] = m[i+1]
+	}
+
+	t, err := ParseFromComponents(values[0], values[1], values[2], values[3])
+	if err != nil {
+		return Zero, fmt.Errorf("[%s] does not match layout [%s]: %w", str, layout, err)
+	}
+	return t, nil
+}
+
+// compileLayout converts layout into an anchored regular expression, along
+// with the component captured by each of its groups in turn.
+func compileLayout(layout string) (*regexp.Regexp, []int, error) {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	var components []int
+	seen := make(map[byte]bool, len(layoutVerbs))
+
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			pattern.WriteString(regexp.QuoteMeta(layout[i : i+1]))
+			continue
+		}
+		i++
+		if i == len(layout) {
+			return nil, nil, fmt.Errorf("it ends with an incomplete verb")
+		}
+		if layout[i] == '%' {
+			pattern.WriteString("%")
+			continue
+		}
+
+		verb, ok := layoutVerbs[layout[i]]
+		if !ok {
+			return nil, nil, fmt.Errorf("[%%%c] is not a known verb", layout[i])
+		}
+		if seen[layout[i]] {
+			return nil, nil, fmt.Errorf("[%%%c] appears more than once", layout[i])
+		}
+		seen[layout[i]] = true
+		pattern.WriteString(verb.pattern)
+		components = append(components, verb.component)
+	}
+
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String()), components, nil
+}
@@ -0,0 +1,99 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCustomFormat_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		layout   string
+		expected timecode.Timecode
+	}{
+		{"01:02:03.456", "%H:%M:%S.%f", timecode.Timecode(3723456)},
+		{"03s02m01h", "%Ss%Mm%Hh", timecode.Timecode(3723000)},
+		{"100h 00m", "%Hh %Mm", 100 * timecode.Hour},
+		{"010203", "%H%M%S", timecode.Timecode(3723000)},
+		{"12345", "%S%f", 12*timecode.Second + 345*timecode.Millisecond},
+		{"50% at 02.5", "50%% at %S.5", 2 * timecode.Second},
+		{"[01:02]", "[%M:%S]", timecode.Minute + 2*timecode.Second},
+		{"nothing", "nothing", timecode.Zero},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseCustomFormat(test.str, test.layout)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseCustomFormat_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		layout   string
+		expected string
+	}{
+		{
+			"01:02:03.456 ",
+			"%H:%M:%S.%f",
+			"[01:02:03.456 ] does not match layout [%H:%M:%S.%f]",
+		},
+		{
+			"x01:02:03",
+			"%H:%M:%S",
+			"[x01:02:03] does not match layout [%H:%M:%S]",
+		},
+		{
+			"01:2:03",
+			"%H:%M:%S",
+			"[01:2:03] does not match layout [%H:%M:%S]",
+		},
+		{
+			"01:02:03.45",
+			"%H:%M:%S.%f",
+			"[01:02:03.45] does not match layout [%H:%M:%S.%f]",
+		},
+		{
+			"01:60:03",
+			"%H:%M:%S",
+			"[01:60:03] does not match layout [%H:%M:%S]: minutes [60] must be below 60",
+		},
+		{
+			"01:02:03",
+			"%H:%M:%S%",
+			"[%H:%M:%S%] is not a valid layout: it ends with an incomplete verb",
+		},
+		{
+			"01:02:03",
+			"%H:%M:%s",
+			"[%H:%M:%s] is not a valid layout: [%s] is not a known verb",
+		},
+		{
+			"01:02:03",
+			"%H:%M:%M",
+			"[%H:%M:%M] is not a valid layout: [%M] appears more than once",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseCustomFormat(test.str, test.layout)
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Package micro provides MicrosecondTimecode, a variant of timecode.Timecode
+// with microsecond resolution for workflows which need sub-millisecond
+// precision, e.g. AES67 audio.
+package micro
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/liampulles/go-timecode"
+)
+
+// Regex can be used to validate microsecond timecodes and capture the sign
+// (optional) hours, minutes, seconds, and fraction (optional) groups. The
+// fraction may be 6 digits of microseconds or 3 digits of milliseconds, and
+// the seperator may either be a dot or a comma.
+var Regex = regexp.MustCompile(`([-])?([01]\d|2[0123]):([012345]\d):([012345]\d)(?:[.,](\d{6}|\d{3}))?`)
+
+// Some basic MicrosecondTimecode units
+const (
+	Zero        = MicrosecondTimecode(0)
+	Microsecond = MicrosecondTimecode(1)
+	Millisecond = Microsecond * 1000
+	Second      = Millisecond * 1000
+	Minute      = Second * 60
+	Hour        = Minute * 60
+)
+
+// MicrosecondTimecode is like timecode.Timecode, but has microsecond
+// resolution. It may be negative.
+type MicrosecondTimecode int64
+
+// Check we implement the interface
+var _ fmt.Stringer = Zero
+
+// HourMinuteSecondMicro returns the constituent elements of a timecode.
+func (t MicrosecondTimecode) HourMinuteSecondMicro() (uint64, uint64, uint64, uint64) {
+	i := uint64(t)
+	if t.IsNegative() {
+		i = uint64(t * -1)
+	}
+
+	micro := i % 1000000
+	i = i / 1000000
+	second := i % 60
+	i = i / 60
+	minute := i % 60
+	i = i / 60
+	hour := i
+
+	return hour, minute, second, micro
+}
+
+// IsNegative is true if MicrosecondTimecode is below zero
+func (t MicrosecondTimecode) IsNegative() bool {
+	return t < Zero
+}
+
+// Format formats a MicrosecondTimecode into a string.
+//
+// If withMicro is true, then microSeperator is used to separate the seconds
+// section from the microseconds section, e.g. "01:02:03.004005".
+func (t MicrosecondTimecode) Format(withMicro bool, microSeperator string) string {
+	negative := t.IsNegative()
+	h, m, s, us := t.HourMinuteSecondMicro()
+
+	var result string
+	if withMicro {
+		result = fmt.Sprintf("%02d:%02d:%02d%s%06d",
+			h, m, s, microSeperator, us)
+	} else {
+		result = fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+	}
+
+	if negative {
+		return fmt.Sprintf("-%s", result)
+	}
+
+	return result
+}
+
+// FormatDot will format as e.g. 01:02:03.004005
+func (t MicrosecondTimecode) FormatDot() string {
+	return t.Format(true, ".")
+}
+
+// String is the same as FormatDot. It should be used for logging; non-business
+// logic purposes as this format is NOT guaranteed.
+func (t MicrosecondTimecode) String() string {
+	return t.FormatDot()
+}
+
+// ToDuration converts t into a time.Duration.
+func (t MicrosecondTimecode) ToDuration() time.Duration {
+	return time.Duration(t) * time.Microsecond
+}
+
+// ToTimecode converts t into a timecode.Timecode, truncating toward zero to
+// millisecond precision.
+func (t MicrosecondTimecode) ToTimecode() timecode.Timecode {
+	return timecode.Timecode(t / Millisecond)
+}
+
+// FromParams constructs a MicrosecondTimecode from its constituent parts.
+func FromParams(negative bool, hour, minute, second, micro uint64) MicrosecondTimecode {
+	total := MicrosecondTimecode(micro) * Microsecond
+	total += MicrosecondTimecode(second) * Second
+	total += MicrosecondTimecode(minute) * Minute
+	total += MicrosecondTimecode(hour) * Hour
+	if negative {
+		total *= -1
+	}
+	return total
+}
+
+// FromDuration converts d into a MicrosecondTimecode, truncating toward zero
+// to microsecond precision.
+func FromDuration(d time.Duration) MicrosecondTimecode {
+	return MicrosecondTimecode(d / time.Microsecond)
+}
+
+// FromTimecode converts t into a MicrosecondTimecode. The conversion is exact.
+func FromTimecode(t timecode.Timecode) MicrosecondTimecode {
+	return MicrosecondTimecode(t) * Millisecond
+}
+
+// Parse extracts a MicrosecondTimecode from a string. The following are valid
+// examples (non-valid timecodes will return an error):
+// "01:02:03.456789"
+// "01:02:03,456789"
+// "-01:02:03.456789"
+// "01:02:03.456"
+// "01:02:03"
+// "crouching.tiger.01:02:03.456789.hidden.timecode"
+func Parse(str string) (MicrosecondTimecode, error) {
+	m := Regex.FindStringSubmatch(str)
+	if len(m) == 0 {
+		return Zero, fmt.Errorf("[%s] is not a timecode", str)
+	}
+
+	negative := m[1] != ""
+	hour := parseNumber(m[2])
+	minute := parseNumber(m[3])
+	second := parseNumber(m[4])
+	micro := parseNumber(m[5])
+	if len(m[5]) == 3 {
+		micro *= 1000
+	}
+
+	return FromParams(negative, hour, minute, second, micro), nil
+}
+
+func parseNumber(value string) uint64 {
+	result, _ := strconv.ParseUint(value, 10, 64)
+	return result
+}
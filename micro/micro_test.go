@@ -0,0 +1,155 @@
+package micro_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/liampulles/go-timecode/micro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMicrosecondTimecode_FormatDot(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode micro.MicrosecondTimecode
+		expected string
+	}{
+		{micro.Zero, "00:00:00.000000"},
+		{micro.Microsecond, "00:00:00.000001"},
+		{micro.Millisecond, "00:00:00.001000"},
+		{micro.Hour + 2*micro.Minute + 3*micro.Second + 4*micro.Millisecond + 5*micro.Microsecond, "01:02:03.004005"},
+		{-(micro.Hour + 2*micro.Minute + 3*micro.Second + 4*micro.Millisecond + 5*micro.Microsecond), "-01:02:03.004005"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatDot()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expected, test.timecode.String())
+		})
+	}
+}
+
+func TestMicrosecondTimecode_Format_NoMicrosecondCase(t *testing.T) {
+	// Setup fixture
+	sut := micro.Hour + 2*micro.Minute + 3*micro.Second + 4*micro.Microsecond
+
+	// Exercise SUT
+	actual := sut.Format(false, "irrelevant")
+
+	// Verify result
+	assert.Equal(t, "01:02:03", actual)
+}
+
+func TestFromParams(t *testing.T) {
+	// Exercise SUT
+	actual := micro.FromParams(true, 1, 2, 3, 456789)
+
+	// Verify result
+	assert.Equal(t, micro.MicrosecondTimecode(-3723456789), actual)
+}
+
+func TestParse_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected micro.MicrosecondTimecode
+	}{
+		{"00:00:00.000000", micro.Zero},
+		{"00:00:00.000001", micro.Microsecond},
+		{"00:00:00,000001", micro.Microsecond},
+		{"00:00:00.001", micro.Millisecond},
+		{"01:02:03.456789", micro.MicrosecondTimecode(3723456789)},
+		{"-01:02:03.456789", micro.MicrosecondTimecode(-3723456789)},
+		{"00:00:01", micro.Second},
+		{"crouching.tiger.00:00:00.000001.hidden.timecode", micro.Microsecond},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := micro.Parse(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParse_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"not.a.timecode",
+		"00:00:0d",
+		"00:00",
+		"24:00:00.000000",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := micro.Parse(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, micro.Zero, actual)
+		})
+	}
+}
+
+func TestDurationConversion(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		duration time.Duration
+		expected micro.MicrosecondTimecode
+	}{
+		{0, micro.Zero},
+		{time.Microsecond, micro.Microsecond},
+		{time.Microsecond + 999*time.Nanosecond, micro.Microsecond},
+		{-time.Microsecond - 999*time.Nanosecond, -micro.Microsecond},
+		{time.Hour, micro.Hour},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := micro.FromDuration(test.duration)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.duration.Truncate(time.Microsecond), actual.ToDuration())
+		})
+	}
+}
+
+func TestTimecodeConversion(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode   micro.MicrosecondTimecode
+		expected   timecode.Timecode
+		roundTrips bool
+	}{
+		{micro.Zero, timecode.Zero, true},
+		{micro.Millisecond, timecode.Millisecond, true},
+		{micro.Millisecond + 999*micro.Microsecond, timecode.Millisecond, false},
+		{-micro.Millisecond - 999*micro.Microsecond, -timecode.Millisecond, false},
+		{micro.MicrosecondTimecode(3723456000), timecode.Timecode(3723456), true},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.ToTimecode()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.roundTrips, micro.FromTimecode(actual) == test.timecode)
+		})
+	}
+}
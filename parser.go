@@ -0,0 +1,217 @@
+package timecode
+
+import (
+	"regexp"
+)
+
+// ParseOption configures the behaviour of ParseWithOptions.
+type ParseOption func(*parseConfig)
+
+type parseConfig struct {
+	strictBounds      bool
+	noEmbedded        bool
+	milliSeperator    string
+	minimumComponents int
+}
+
+// WithStrictBounds rejects timecodes which are not IsValid, i.e. negative
+// timecodes.
+func WithStrictBounds() ParseOption {
+	return func(c *parseConfig) {
+		c.strictBounds = true
+	}
+}
+
+// WithNoEmbedded requires the timecode to make up the entire string, rather
+// than allowing it to be embedded in other text.
+func WithNoEmbedded() ParseOption {
+	return func(c *parseConfig) {
+		c.noEmbedded = true
+	}
+}
+
+// WithCustomSeparator accepts sep, rather than a dot or comma, as the
+// separator between the seconds and milliseconds. An empty sep restores the
+// default.
+func WithCustomSeparator(sep string) ParseOption {
+	return func(c *parseConfig) {
+		c.milliSeperator = sep
+	}
+}
+
+// WithMinimumComponents sets how many of the hours, minutes, seconds, and
+// milliseconds must be present, counting from the seconds outward. The default
+// is 3 (i.e. "HH:MM:SS"). 4 requires the milliseconds, 2 allows "MM:SS", and 1
+// allows "SS". Values outside of 1 to 4 are clamped. Below 3, a timecode must
+// not be part of a longer run of digits and colons, e.g. "24:00:00" is not
+// taken to hold "24:00".
+func WithMinimumComponents(n int) ParseOption {
+	return func(c *parseConfig) {
+		c.minimumComponents = n
+	}
+}
+
+// ParseWithOptions is like Parse, but with its behaviour adjusted by opts. E.g.
+// ParseWithOptions(str, WithNoEmbedded(), WithStrictBounds()). With no opts,
+// it behaves exactly as Parse.
+//
+// Each call compiles a new regular expression, so NewParser should be
+// preferred when parsing many strings with the same opts.
+func ParseWithOptions(str string, opts ...ParseOption) (Timecode, error) {
+	return NewParser(opts...).Parse(str)
+}
+
+// Parser parses Timecodes according to a fixed set of ParseOptions. It is safe
+// for concurrent use.
+type Parser struct {
+	config  parseConfig
+	regex   *regexp.Regexp
+	grammar grammar
+}
+
+// NewParser constructs a Parser configured by opts. With no opts, the Parser
+// uses Regex and behaves exactly as the package level Parse and ParseAll.
+func NewParser(opts ...ParseOption) *Parser {
+	c := parseConfig{minimumComponents: 3}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.minimumComponents < 1 {
+		c.minimumComponents = 1
+	} else if c.minimumComponents > 4 {
+		c.minimumComponents = 4
+	}
+	re := c.regex()
+	if re == Regex {
+		return &Parser{config: c, regex: re, grammar: defaultGrammar}
+	}
+	sep := "."
+	if c.milliSeperator != "" {
+		sep = c.milliSeperator
+	}
+	return &Parser{config: c, regex: re, grammar: newGrammar(re, sep)}
+}
+
+// Parse is like the package level Parse, but follows the Parser's options.
+// Note that with WithStrictBounds, a timecode which is not IsValid gives the
+// error of Validate rather than a *ParseError.
+func (p *Parser) Parse(str string) (Timecode, error) {
+	loc := p.find(str)
+	if loc == nil {
+		return Zero, newParseError(str, p.grammar)
+	}
+	if p.config.noEmbedded && (loc[0] != 0 || loc[1] != len(str)) {
+		offset := loc[1]
+		if loc[0] != 0 {
+			offset = 0
+		}
+		return Zero, &ParseError{Input: str, Offset: offset}
+	}
+
+	t := fromRegexMatch(submatches(str, loc))
+	if p.config.strictBounds {
+		if err := t.Validate(); err != nil {
+			return Zero, err
+		}
+	}
+	return t, nil
+}
+
+// ParseAll is like the package level ParseAll, but follows the Parser's
+// options. With WithNoEmbedded, the result holds the single timecode making up
+// str, if any. With WithStrictBounds, timecodes which are not IsValid are
+// left out.
+func (p *Parser) ParseAll(str string) []Timecode {
+	if p.config.noEmbedded {
+		t, err := p.Parse(str)
+		if err != nil {
+			return []Timecode{}
+		}
+		return []Timecode{t}
+	}
+
+	locs := p.findAll(str)
+	result := make([]Timecode, 0, len(locs))
+	for _, loc := range locs {
+		t := fromRegexMatch(submatches(str, loc))
+		if p.config.strictBounds && !t.IsValid() {
+			continue
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// find is like findAll, but finds only the first match, or nil if there is
+// none.
+func (p *Parser) find(str string) []int {
+	if p.config.minimumComponents >= 3 {
+		return p.regex.FindStringSubmatchIndex(str)
+	}
+	locs := p.findAll(str)
+	if len(locs) == 0 {
+		return nil
+	}
+	return locs[0]
+}
+
+// findAll finds the matches of the Parser's regex in str, as per
+// FindAllStringSubmatchIndex. With fewer than 3 minimum components, matches
+// which start or end partway through a run of digits and colons are left out,
+// so that e.g. "24:00:00" does not give "24:00", and "420" does not give "42".
+func (p *Parser) findAll(str string) [][]int {
+	locs := p.regex.FindAllStringSubmatchIndex(str, -1)
+	if p.config.minimumComponents >= 3 {
+		return locs
+	}
+
+	result := locs[:0]
+	for _, loc := range locs {
+		if loc[0] > 0 && isTimecodeByte(str[loc[0]-1]) {
+			continue
+		}
+		if loc[1] < len(str) && isTimecodeByte(str[loc[1]]) {
+			continue
+		}
+		result = append(result, loc)
+	}
+	return result
+}
+
+// regex builds a regular expression with the same capture groups as Regex.
+func (c parseConfig) regex() *regexp.Regexp {
+	if c.milliSeperator == "" && c.minimumComponents == 3 {
+		return Regex
+	}
+
+	sep := `[.,]`
+	if c.milliSeperator != "" {
+		sep = regexp.QuoteMeta(c.milliSeperator)
+	}
+	milli := `(?:` + sep + `(\d{3}))`
+	if c.minimumComponents < 4 {
+		milli += `?`
+	}
+
+	hms := `([01]\d|2[0123]):([012345]\d):([012345]\d)`
+	switch c.minimumComponents {
+	case 2:
+		hms = `(?:([01]\d|2[0123]):)?([012345]\d):([012345]\d)`
+	case 1:
+		hms = `(?:(?:([01]\d|2[0123]):)?([012345]\d):)?([012345]\d)`
+	}
+
+	return regexp.MustCompile(`([-])?` + hms + milli)
+}
+
+// submatches converts the result of FindStringSubmatchIndex into that of
+// FindStringSubmatch.
+func submatches(str string, loc []int) []string {
+	m := make([]string, len(loc)/2)
+	for i := range m {
+		if loc[2*i] >= 0 {
+			m[i] = str[loc[2*i]:loc[2*i+1]]
+		}
+	}
+	return m
+}
@@ -0,0 +1,192 @@
+package timecode_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWithOptions_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		opts     []timecode.ParseOption
+		expected timecode.Timecode
+	}{
+		{"crouching.tiger.-01:02:03.456.hidden", nil, timecode.Timecode(-3723456)},
+		{"01:02:03,456", nil, timecode.Timecode(3723456)},
+		{"01:02:03.456", []timecode.ParseOption{timecode.WithNoEmbedded()}, timecode.Timecode(3723456)},
+		{"01:02:03", []timecode.ParseOption{timecode.WithStrictBounds()}, timecode.Timecode(3723000)},
+		{"01:02:03;456", []timecode.ParseOption{timecode.WithCustomSeparator(";")}, timecode.Timecode(3723456)},
+		{"01:02:03.456", []timecode.ParseOption{timecode.WithCustomSeparator(".")}, timecode.Timecode(3723456)},
+		{"01:02:03,456", []timecode.ParseOption{timecode.WithCustomSeparator("")}, timecode.Timecode(3723456)},
+		{"01:02:03.456", []timecode.ParseOption{timecode.WithMinimumComponents(4)}, timecode.Timecode(3723456)},
+		{"01:02:03.456", []timecode.ParseOption{timecode.WithMinimumComponents(9)}, timecode.Timecode(3723456)},
+		{"at 02:03.456", []timecode.ParseOption{timecode.WithMinimumComponents(2)}, timecode.Timecode(123456)},
+		{"01:02:03", []timecode.ParseOption{timecode.WithMinimumComponents(2)}, timecode.Timecode(3723000)},
+		{"-03", []timecode.ParseOption{timecode.WithMinimumComponents(1)}, -3 * timecode.Second},
+		{"03", []timecode.ParseOption{timecode.WithMinimumComponents(-1)}, 3 * timecode.Second},
+		{
+			"-02:03",
+			[]timecode.ParseOption{timecode.WithNoEmbedded(), timecode.WithMinimumComponents(2)},
+			-(2*timecode.Minute + 3*timecode.Second),
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseWithOptions(test.str, test.opts...)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseWithOptions_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str            string
+		opts           []timecode.ParseOption
+		expectedOffset int
+	}{
+		{"not.a.timecode", nil, -1},
+		{"at 01:02:03", []timecode.ParseOption{timecode.WithNoEmbedded()}, 0},
+		{"01:02:03.456 ", []timecode.ParseOption{timecode.WithNoEmbedded()}, 12},
+		{"01:02:03.456", []timecode.ParseOption{timecode.WithNoEmbedded(), timecode.WithCustomSeparator(";")}, 8},
+		{"01:02:03", []timecode.ParseOption{timecode.WithMinimumComponents(4)}, 8},
+		{"02:03", nil, 5},
+		{"24:00:00", []timecode.ParseOption{timecode.WithMinimumComponents(2)}, 5},
+		{"24:00:00", []timecode.ParseOption{timecode.WithMinimumComponents(1)}, 5},
+		{"room 420", []timecode.ParseOption{timecode.WithMinimumComponents(1)}, 7},
+		{"01:02:03;45x", []timecode.ParseOption{timecode.WithMinimumComponents(4), timecode.WithCustomSeparator(";")}, 11},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseWithOptions(test.str, test.opts...)
+
+			// Verify result
+			var parseErr *timecode.ParseError
+			assert.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, &timecode.ParseError{Input: test.str, Offset: test.expectedOffset}, parseErr)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestParseWithOptions_StrictBounds(t *testing.T) {
+	// Exercise SUT
+	actual, err := timecode.ParseWithOptions("-00:00:01", timecode.WithStrictBounds())
+
+	// Verify result
+	assert.EqualError(t, err, "[-00:00:01.000] is outside of the valid timecode range [00:00:00.000, 23:59:59.999]")
+	var parseErr *timecode.ParseError
+	assert.False(t, errors.As(err, &parseErr))
+	assert.Equal(t, timecode.Zero, actual)
+}
+
+func TestNewParser_Default(t *testing.T) {
+	// Setup fixture
+	sut := timecode.NewParser()
+	inputs := []string{"-01:02:03.456", "crouching.tiger.00:00:01.hidden", "not.a.timecode", "00:0d:00"}
+
+	for i, input := range inputs {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup expectations
+			expected, expectedErr := timecode.Parse(input)
+
+			// Exercise SUT
+			actual, err := sut.Parse(input)
+
+			// Verify result
+			assert.Equal(t, expected, actual)
+			assert.Equal(t, expectedErr, err)
+			assert.Equal(t, timecode.ParseAll(input), sut.ParseAll(input))
+		})
+	}
+}
+
+func TestParser_Parse(t *testing.T) {
+	// Setup fixture
+	sut := timecode.NewParser(timecode.WithNoEmbedded(), timecode.WithCustomSeparator(":"))
+
+	// Exercise SUT
+	valid, validErr := sut.Parse("01:02:03:456")
+	invalid, invalidErr := sut.Parse("01:02:03.456")
+
+	// Verify result
+	assert.NoError(t, validErr)
+	assert.Equal(t, timecode.Timecode(3723456), valid)
+	assert.Error(t, invalidErr)
+	assert.Equal(t, timecode.Zero, invalid)
+}
+
+func TestParser_ParseAll(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		opts     []timecode.ParseOption
+		str      string
+		expected []timecode.Timecode
+	}{
+		{
+			nil,
+			"00:00:01.000 --> -00:00:02.000",
+			[]timecode.Timecode{timecode.Second, -2 * timecode.Second},
+		},
+		{
+			[]timecode.ParseOption{timecode.WithStrictBounds()},
+			"00:00:01.000 --> -00:00:02.000",
+			[]timecode.Timecode{timecode.Second},
+		},
+		{
+			[]timecode.ParseOption{timecode.WithMinimumComponents(2)},
+			"00:01 --> 01:00:02",
+			[]timecode.Timecode{timecode.Second, timecode.Hour + 2*timecode.Second},
+		},
+		{
+			[]timecode.ParseOption{timecode.WithMinimumComponents(2)},
+			"24:00:00 --> 123:45 --> 00:03",
+			[]timecode.Timecode{3 * timecode.Second},
+		},
+		{
+			[]timecode.ParseOption{timecode.WithMinimumComponents(1)},
+			"room 420, shelf 42",
+			[]timecode.Timecode{42 * timecode.Second},
+		},
+		{
+			[]timecode.ParseOption{timecode.WithNoEmbedded()},
+			"00:00:01.000 --> 00:00:02.000",
+			[]timecode.Timecode{},
+		},
+		{
+			[]timecode.ParseOption{timecode.WithNoEmbedded()},
+			"00:00:01.000",
+			[]timecode.Timecode{timecode.Second},
+		},
+		{
+			nil,
+			"nothing here",
+			[]timecode.Timecode{},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.NewParser(test.opts...)
+
+			// Exercise SUT
+			actual := sut.ParseAll(test.str)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
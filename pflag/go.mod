@@ -0,0 +1,11 @@
+module github.com/liampulles/go-timecode/pflag
+
+go 1.15
+
+replace github.com/liampulles/go-timecode => ../
+
+require (
+	github.com/liampulles/go-timecode v0.0.0-00010101000000-000000000000
+	github.com/spf13/pflag v1.0.5
+	github.com/stretchr/testify v1.6.1
+)
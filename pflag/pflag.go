@@ -0,0 +1,20 @@
+// Package pflag provides helpers for registering Timecode flags with
+// github.com/spf13/pflag (and therefore cobra). It is its own module, so that
+// pflag is only required by those who use it.
+package pflag
+
+import (
+	"github.com/liampulles/go-timecode"
+	"github.com/spf13/pflag"
+)
+
+// Check we implement the interface
+var _ pflag.Value = (*timecode.Timecode)(nil)
+
+// TimecodeVar defines a Timecode flag with the specified name, default value,
+// and usage string on fs. The argument p points to a Timecode variable in
+// which to store the value of the flag. It mirrors pflag.StringVar.
+func TimecodeVar(fs *pflag.FlagSet, p *timecode.Timecode, name string, value timecode.Timecode, usage string) {
+	*p = value
+	fs.Var(p, name, usage)
+}
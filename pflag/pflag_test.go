@@ -0,0 +1,56 @@
+package pflag_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	tcpflag "github.com/liampulles/go-timecode/pflag"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecodeVar_Default(t *testing.T) {
+	// Setup fixture
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	var actual timecode.Timecode
+	tcpflag.TimecodeVar(fs, &actual, "start-time", timecode.Minute, "usage")
+
+	// Exercise SUT
+	err := fs.Parse([]string{})
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, timecode.Minute, actual)
+	assert.Equal(t, "00:01:00.000", fs.Lookup("start-time").DefValue)
+	assert.Equal(t, "timecode", fs.Lookup("start-time").Value.Type())
+}
+
+func TestTimecodeVar_ValidCase(t *testing.T) {
+	// Setup fixture
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	var actual timecode.Timecode
+	tcpflag.TimecodeVar(fs, &actual, "start-time", timecode.Zero, "usage")
+
+	// Exercise SUT
+	err := fs.Parse([]string{"--start-time", "01:02:03.456"})
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, timecode.Timecode(3723456), actual)
+}
+
+func TestTimecodeVar_InvalidCase(t *testing.T) {
+	// Setup fixture
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	var actual timecode.Timecode
+	tcpflag.TimecodeVar(fs, &actual, "start-time", timecode.Zero, "usage")
+
+	// Exercise SUT
+	err := fs.Parse([]string{"--start-time", "not.a.timecode"})
+
+	// Verify result
+	assert.Error(t, err)
+	assert.Equal(t, timecode.Zero, actual)
+}
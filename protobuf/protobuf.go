@@ -0,0 +1,33 @@
+// Package protobuf converts between Timecode and the protobuf well-known
+// google.protobuf.Duration type, for use in gRPC services and protobuf
+// schemas.
+//
+// This package is its own module, so that google.golang.org/protobuf is not a
+// requirement of go-timecode itself.
+package protobuf
+
+import (
+	"github.com/liampulles/go-timecode"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const nanosPerMilli = 1000000
+
+// ToProtoDuration converts tc into a Duration. The conversion is exact.
+func ToProtoDuration(tc timecode.Timecode) *durationpb.Duration {
+	milli := int64(tc)
+	return &durationpb.Duration{
+		Seconds: milli / 1000,
+		Nanos:   int32(milli%1000) * nanosPerMilli,
+	}
+}
+
+// FromProtoDuration converts d into a Timecode. Sub-millisecond precision is
+// truncated toward zero, and a nil d gives Zero.
+func FromProtoDuration(d *durationpb.Duration) timecode.Timecode {
+	if d == nil {
+		return timecode.Zero
+	}
+	milli := d.GetSeconds()*1000 + int64(d.GetNanos()/nanosPerMilli)
+	return timecode.Timecode(milli)
+}
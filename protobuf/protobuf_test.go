@@ -0,0 +1,62 @@
+package protobuf_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/liampulles/go-timecode/protobuf"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestToProtoDuration(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode        timecode.Timecode
+		expectedSeconds int64
+		expectedNanos   int32
+	}{
+		{timecode.Zero, 0, 0},
+		{timecode.Millisecond, 0, 1000000},
+		{timecode.Timecode(3723456), 3723, 456000000},
+		{timecode.Timecode(-3723456), -3723, -456000000},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := protobuf.ToProtoDuration(test.timecode)
+
+			// Verify result
+			assert.Equal(t, test.expectedSeconds, actual.GetSeconds())
+			assert.Equal(t, test.expectedNanos, actual.GetNanos())
+			assert.NoError(t, actual.CheckValid())
+		})
+	}
+}
+
+func TestFromProtoDuration(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		duration *durationpb.Duration
+		expected timecode.Timecode
+	}{
+		{nil, timecode.Zero},
+		{&durationpb.Duration{}, timecode.Zero},
+		{&durationpb.Duration{Seconds: 3723, Nanos: 456000000}, timecode.Timecode(3723456)},
+		{&durationpb.Duration{Seconds: -3723, Nanos: -456000000}, timecode.Timecode(-3723456)},
+		{&durationpb.Duration{Seconds: 1, Nanos: 999999}, timecode.Second},
+		{&durationpb.Duration{Seconds: -1, Nanos: -999999}, -timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := protobuf.FromProtoDuration(test.duration)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package timecode
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Range is an interval of time between a Start and End Timecode, e.g. for a
+// subtitle cue or chapter.
+//
+// Ranges are half-open: Start is within the Range, but End is not.
+type Range struct {
+	Start Timecode
+	End   Timecode
+}
+
+// Check we implement the interface
+var _ fmt.Stringer = Range{}
+
+// Duration is the length of the Range. It is negative for invalid Ranges.
+func (r Range) Duration() Timecode {
+	return r.End - r.Start
+}
+
+// Contains is true if t is at or after Start, and before End.
+func (r Range) Contains(t Timecode) bool {
+	return r.Start <= t && t < r.End
+}
+
+// Overlaps is true if r and other share any time, i.e. if one starts before
+// the other ends. Ranges which merely touch do not overlap.
+func (r Range) Overlaps(other Range) bool {
+	return r.Start < other.End && other.Start < r.End
+}
+
+// Intersection returns the time shared by r and other, and true, if they
+// Overlap. Otherwise it returns Range{} and false.
+func (r Range) Intersection(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+	return Range{Start: Max(r.Start, other.Start), End: Min(r.End, other.End)}, true
+}
+
+// Union returns the smallest Range covering both r and other. An error is
+// returned if there is a gap between them, though Ranges which merely touch
+// may be joined.
+func (r Range) Union(other Range) (Range, error) {
+	if r.End < other.Start || other.End < r.Start {
+		return Range{}, fmt.Errorf("cannot join disjoint ranges [%s] and [%s]", r, other)
+	}
+	return Range{Start: Min(r.Start, other.Start), End: Max(r.End, other.End)}, nil
+}
+
+// IsValid is true if Start is not after End.
+func (r Range) IsValid() bool {
+	return r.Start <= r.End
+}
+
+// Shift returns r moved by offset, which may be negative. The result may have
+// a negative Start.
+func (r Range) Shift(offset Timecode) Range {
+	return Range{Start: r.Start + offset, End: r.End + offset}
+}
+
+// ShiftAll returns a new slice of ranges, each moved by offset.
+func ShiftAll(ranges []Range, offset Timecode) []Range {
+	result := make([]Range, len(ranges))
+	for i, r := range ranges {
+		result[i] = r.Shift(offset)
+	}
+	return result
+}
+
+// Split divides r into n contiguous sub-ranges of equal duration, which
+// together exactly cover r. If the duration does not divide evenly, the
+// remaining milliseconds are added to the last sub-range. An error is
+// returned if n is not positive, or if r is not valid.
+func (r Range) Split(n int) ([]Range, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("cannot split a range into [%d] parts", n)
+	}
+	if !r.IsValid() {
+		return nil, fmt.Errorf("cannot split invalid range [%s]", r)
+	}
+
+	step := r.Duration() / Timecode(n)
+	result := make([]Range, n)
+	start := r.Start
+	for i := range result {
+		result[i] = Range{Start: start, End: start + step}
+		start += step
+	}
+	result[n-1].End = r.End
+	return result, nil
+}
+
+// String formats as e.g. "01:02:03.004 --> 01:02:05.006". It should be used
+// for logging; non-business logic purposes as this format is NOT guaranteed.
+func (r Range) String() string {
+	return fmt.Sprintf("%s --> %s", r.Start, r.End)
+}
+
+// Format formats r as its Start and End Timecodes joined by sep, with
+// milliSeperator separating the seconds and milliseconds of each. E.g.
+// r.Format(" --> ", ",") gives an SRT timing line like
+// "00:01:00,000 --> 00:02:00,000".
+func (r Range) Format(sep, milliSeperator string) string {
+	return r.Start.Format(true, milliSeperator) + sep + r.End.Format(true, milliSeperator)
+}
+
+// ParseRange extracts a Range from a string of two timecodes joined by a
+// separator, optionally surrounded by square brackets. The following are all
+// accepted:
+// "00:01:00,000 --> 00:02:00,000" (SRT and WebVTT)
+// "00:01:00.000 -> 00:02:00.000"
+// "00:01:00.000 - 00:02:00.000" (some EDLs)
+// "[00:01:00.000, 00:02:00.000]" (JSON)
+//
+// Each endpoint is parsed with Parse, and whitespace around endpoints and
+// separators is ignored. The error identifies which endpoint is invalid.
+func ParseRange(str string) (Range, error) {
+	trimmed := strings.TrimSpace(str)
+	if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+		trimmed = trimmed[1 : len(trimmed)-1]
+	}
+	startStr, endStr, ok := splitRange(trimmed)
+	if !ok {
+		return Range{}, fmt.Errorf("[%s] is not a timecode range", str)
+	}
+
+	start, err := Parse(strings.TrimSpace(startStr))
+	if err != nil {
+		return Range{}, fmt.Errorf("[%s] has an invalid start: %v", str, err)
+	}
+	end, err := Parse(strings.TrimSpace(endStr))
+	if err != nil {
+		return Range{}, fmt.Errorf("[%s] has an invalid end: %v", str, err)
+	}
+
+	return Range{Start: start, End: end}, nil
+}
+
+// splitRange splits str around the first of the separators accepted by
+// ParseRange that it contains, trying arrows first. A comma counts as a
+// separator unless it starts the milliseconds of a timecode, and a hyphen
+// unless it is the sign of one.
+func splitRange(str string) (string, string, bool) {
+	for _, arrow := range []string{"-->", "->"} {
+		if i := strings.Index(str, arrow); i >= 0 {
+			return str[:i], str[i+len(arrow):], true
+		}
+	}
+
+	for i := 0; i < len(str); i++ {
+		if str[i] == ',' && !isMilliDigits(str[i+1:]) {
+			return str[:i], str[i+1:], true
+		}
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] == '-' && endsWithDigit(strings.TrimRight(str[:i], " \t")) {
+			return str[:i], str[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+// isMilliDigits is true if str starts with exactly 3 digits.
+func isMilliDigits(str string) bool {
+	if len(str) < 3 {
+		return false
+	}
+	for i := 0; i < 3; i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return len(str) == 3 || str[3] < '0' || str[3] > '9'
+}
+
+func endsWithDigit(str string) bool {
+	return str != "" && '0' <= str[len(str)-1] && str[len(str)-1] <= '9'
+}
@@ -0,0 +1,505 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange_Duration(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r        timecode.Range
+		expected timecode.Timecode
+	}{
+		{timecode.Range{}, timecode.Zero},
+		{timecode.Range{Start: timecode.Second, End: timecode.Minute}, 59 * timecode.Second},
+		{timecode.Range{Start: -timecode.Second, End: timecode.Second}, 2 * timecode.Second},
+		{timecode.Range{Start: timecode.Minute, End: timecode.Second}, -59 * timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.r.Duration()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: timecode.Second, End: timecode.Minute}
+
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected bool
+	}{
+		{timecode.Zero, false},
+		{timecode.Second - timecode.Millisecond, false},
+		{timecode.Second, true},
+		{30 * timecode.Second, true},
+		{timecode.Minute - timecode.Millisecond, true},
+		{timecode.Minute, false},
+		{-30 * timecode.Second, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := sut.Contains(test.timecode)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRange_Overlaps(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: 10 * timecode.Second, End: 20 * timecode.Second}
+
+	// Setup expectations
+	var tests = []struct {
+		other    timecode.Range
+		expected bool
+	}{
+		{timecode.Range{Start: timecode.Zero, End: 5 * timecode.Second}, false},
+		{timecode.Range{Start: timecode.Zero, End: 10 * timecode.Second}, false},
+		{timecode.Range{Start: timecode.Zero, End: 11 * timecode.Second}, true},
+		{timecode.Range{Start: 12 * timecode.Second, End: 15 * timecode.Second}, true},
+		{timecode.Range{Start: 5 * timecode.Second, End: 25 * timecode.Second}, true},
+		{timecode.Range{Start: 19 * timecode.Second, End: 25 * timecode.Second}, true},
+		{timecode.Range{Start: 20 * timecode.Second, End: 25 * timecode.Second}, false},
+		{sut, true},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := sut.Overlaps(test.other)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expected, test.other.Overlaps(sut))
+		})
+	}
+}
+
+func TestRange_Intersection(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: 10 * timecode.Second, End: 20 * timecode.Second}
+
+	// Setup expectations
+	var tests = []struct {
+		other      timecode.Range
+		expected   timecode.Range
+		expectedOk bool
+	}{
+		{timecode.Range{Start: timecode.Zero, End: 5 * timecode.Second}, timecode.Range{}, false},
+		{timecode.Range{Start: timecode.Zero, End: 10 * timecode.Second}, timecode.Range{}, false},
+		{
+			timecode.Range{Start: timecode.Zero, End: 11 * timecode.Second},
+			timecode.Range{Start: 10 * timecode.Second, End: 11 * timecode.Second},
+			true,
+		},
+		{
+			timecode.Range{Start: 12 * timecode.Second, End: 15 * timecode.Second},
+			timecode.Range{Start: 12 * timecode.Second, End: 15 * timecode.Second},
+			true,
+		},
+		{timecode.Range{Start: 5 * timecode.Second, End: 25 * timecode.Second}, sut, true},
+		{
+			timecode.Range{Start: 19 * timecode.Second, End: 25 * timecode.Second},
+			timecode.Range{Start: 19 * timecode.Second, End: 20 * timecode.Second},
+			true,
+		},
+		{timecode.Range{Start: 20 * timecode.Second, End: 25 * timecode.Second}, timecode.Range{}, false},
+		{sut, sut, true},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, ok := sut.Intersection(test.other)
+
+			// Verify result
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expected, actual)
+			reverse, reverseOk := test.other.Intersection(sut)
+			assert.Equal(t, test.expectedOk, reverseOk)
+			assert.Equal(t, test.expected, reverse)
+		})
+	}
+}
+
+func TestRange_Union_ValidCases(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: 10 * timecode.Second, End: 20 * timecode.Second}
+
+	// Setup expectations
+	var tests = []struct {
+		other    timecode.Range
+		expected timecode.Range
+	}{
+		{
+			timecode.Range{Start: timecode.Zero, End: 10 * timecode.Second},
+			timecode.Range{Start: timecode.Zero, End: 20 * timecode.Second},
+		},
+		{
+			timecode.Range{Start: 5 * timecode.Second, End: 15 * timecode.Second},
+			timecode.Range{Start: 5 * timecode.Second, End: 20 * timecode.Second},
+		},
+		{timecode.Range{Start: 12 * timecode.Second, End: 15 * timecode.Second}, sut},
+		{
+			timecode.Range{Start: 5 * timecode.Second, End: 25 * timecode.Second},
+			timecode.Range{Start: 5 * timecode.Second, End: 25 * timecode.Second},
+		},
+		{
+			timecode.Range{Start: 20 * timecode.Second, End: 25 * timecode.Second},
+			timecode.Range{Start: 10 * timecode.Second, End: 25 * timecode.Second},
+		},
+		{sut, sut},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := sut.Union(test.other)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+			reverse, err := test.other.Union(sut)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, reverse)
+		})
+	}
+}
+
+func TestRange_Union_Disjoint(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: 10 * timecode.Second, End: 20 * timecode.Second}
+	other := timecode.Range{Start: 21 * timecode.Second, End: 25 * timecode.Second}
+
+	// Exercise SUT
+	actual, err := sut.Union(other)
+
+	// Verify result
+	assert.EqualError(t, err,
+		"cannot join disjoint ranges [00:00:10.000 --> 00:00:20.000] and [00:00:21.000 --> 00:00:25.000]")
+	assert.Equal(t, timecode.Range{}, actual)
+	_, err = other.Union(sut)
+	assert.Error(t, err)
+}
+
+func TestRange_IsValid(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r        timecode.Range
+		expected bool
+	}{
+		{timecode.Range{}, true},
+		{timecode.Range{Start: timecode.Second, End: timecode.Minute}, true},
+		{timecode.Range{Start: -timecode.Minute, End: -timecode.Second}, true},
+		{timecode.Range{Start: timecode.Minute, End: timecode.Second}, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.r.IsValid()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRange_Shift(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: timecode.Second, End: timecode.Minute}
+
+	// Setup expectations
+	var tests = []struct {
+		offset   timecode.Timecode
+		expected timecode.Range
+	}{
+		{timecode.Zero, sut},
+		{timecode.Second, timecode.Range{Start: 2 * timecode.Second, End: timecode.Minute + timecode.Second}},
+		{-timecode.Second, timecode.Range{Start: timecode.Zero, End: 59 * timecode.Second}},
+		{-2 * timecode.Second, timecode.Range{Start: -timecode.Second, End: 58 * timecode.Second}},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := sut.Shift(test.offset)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestShiftAll(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Range{
+		{Start: timecode.Zero, End: timecode.Second},
+		{Start: timecode.Minute, End: 2 * timecode.Minute},
+	}
+
+	// Exercise SUT
+	actual := timecode.ShiftAll(fixture, -timecode.Second)
+
+	// Verify result
+	assert.Equal(t, []timecode.Range{
+		{Start: -timecode.Second, End: timecode.Zero},
+		{Start: 59 * timecode.Second, End: 2*timecode.Minute - timecode.Second},
+	}, actual)
+	assert.Equal(t, timecode.Zero, fixture[0].Start)
+}
+
+func TestShiftAll_Empty(t *testing.T) {
+	// Exercise SUT
+	actual := timecode.ShiftAll(nil, timecode.Second)
+
+	// Verify result
+	assert.Empty(t, actual)
+}
+
+func TestRange_Split_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r        timecode.Range
+		n        int
+		expected []timecode.Range
+	}{
+		{
+			timecode.Range{Start: timecode.Second, End: timecode.Minute},
+			1,
+			[]timecode.Range{{Start: timecode.Second, End: timecode.Minute}},
+		},
+		{
+			timecode.Range{Start: timecode.Zero, End: 3 * timecode.Second},
+			3,
+			[]timecode.Range{
+				{Start: timecode.Zero, End: timecode.Second},
+				{Start: timecode.Second, End: 2 * timecode.Second},
+				{Start: 2 * timecode.Second, End: 3 * timecode.Second},
+			},
+		},
+		{
+			timecode.Range{Start: -5 * timecode.Millisecond, End: 5 * timecode.Millisecond},
+			3,
+			[]timecode.Range{
+				{Start: -5 * timecode.Millisecond, End: -2 * timecode.Millisecond},
+				{Start: -2 * timecode.Millisecond, End: 1 * timecode.Millisecond},
+				{Start: 1 * timecode.Millisecond, End: 5 * timecode.Millisecond},
+			},
+		},
+		{
+			timecode.Range{Start: timecode.Zero, End: 2 * timecode.Millisecond},
+			3,
+			[]timecode.Range{
+				{Start: timecode.Zero, End: timecode.Zero},
+				{Start: timecode.Zero, End: timecode.Zero},
+				{Start: timecode.Zero, End: 2 * timecode.Millisecond},
+			},
+		},
+		{
+			timecode.Range{Start: timecode.Second, End: timecode.Second},
+			2,
+			[]timecode.Range{
+				{Start: timecode.Second, End: timecode.Second},
+				{Start: timecode.Second, End: timecode.Second},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := test.r.Split(test.n)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRange_Split_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r timecode.Range
+		n int
+	}{
+		{timecode.Range{Start: timecode.Zero, End: timecode.Second}, 0},
+		{timecode.Range{Start: timecode.Zero, End: timecode.Second}, -1},
+		{timecode.Range{Start: timecode.Second, End: timecode.Zero}, 2},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := test.r.Split(test.n)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Nil(t, actual)
+		})
+	}
+}
+
+func TestRange_String(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: timecode.Timecode(3723004), End: timecode.Timecode(3725006)}
+
+	// Exercise SUT
+	actual := sut.String()
+
+	// Verify result
+	assert.Equal(t, "01:02:03.004 --> 01:02:05.006", actual)
+}
+
+func TestRange_Format(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Range{Start: timecode.Minute, End: timecode.Timecode(-3723456)}
+
+	// Exercise SUT
+	actual := sut.Format(" --> ", ",")
+
+	// Verify result
+	assert.Equal(t, "00:01:00,000 --> -01:02:03,456", actual)
+}
+
+func TestParseRange_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Range
+	}{
+		{
+			"00:01:00,000 --> 00:02:00,000",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			"00:01:00.000 -> 00:02:00.000",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			"  00:01:00.000-->00:02:00.000 \r\n",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			"-00:00:01.000 --> -00:00:00.500",
+			timecode.Range{Start: -timecode.Second, End: -500 * timecode.Millisecond},
+		},
+		{
+			"-00:00:01.000->-00:00:00.500",
+			timecode.Range{Start: -timecode.Second, End: -500 * timecode.Millisecond},
+		},
+		{
+			"00:01:00.000 - 00:02:00.000",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			"00:01:00,000-00:02:00,000",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			"-00:00:01.000 - -00:00:00.500",
+			timecode.Range{Start: -timecode.Second, End: -500 * timecode.Millisecond},
+		},
+		{
+			"[00:01:00.000, 00:02:00.000]",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			" [ 00:01:00,000,00:02:00,000 ] ",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+		{
+			"[-00:00:01,-00:00:00,500]",
+			timecode.Range{Start: -timecode.Second, End: -500 * timecode.Millisecond},
+		},
+		{
+			"[00:01:00.000 --> 00:02:00.000]",
+			timecode.Range{Start: timecode.Minute, End: 2 * timecode.Minute},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseRange(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseRange_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected string
+	}{
+		{"", "[] is not a timecode range"},
+		{"00:01:00.000", "[00:01:00.000] is not a timecode range"},
+		{"00:01:00,000", "[00:01:00,000] is not a timecode range"},
+		{"-00:01:00.000", "[-00:01:00.000] is not a timecode range"},
+		{"00:01:00.000 00:02:00.000", "[00:01:00.000 00:02:00.000] is not a timecode range"},
+		{
+			"not.a.timecode --> 00:02:00.000",
+			"[not.a.timecode --> 00:02:00.000] has an invalid start: [not.a.timecode] is not a timecode",
+		},
+		{
+			"00:01:00.000 --> not.a.timecode",
+			"[00:01:00.000 --> not.a.timecode] has an invalid end: [not.a.timecode] is not a timecode",
+		},
+		{
+			"00:01:00.000 --> ",
+			"[00:01:00.000 --> ] has an invalid end: [] is not a timecode",
+		},
+		{
+			"[00:01:00.000, 00:0x:00.000]",
+			"[[00:01:00.000, 00:0x:00.000]] has an invalid end: [00:0x:00.000] is not a timecode",
+		},
+		{
+			"00:61:00 - 00:02:00",
+			"[00:61:00 - 00:02:00] has an invalid start: [00:61:00] is not a timecode",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseRange(test.str)
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, timecode.Range{}, actual)
+		})
+	}
+}
+
+func TestRange_FormatParseRange_RoundTrip(t *testing.T) {
+	// Setup fixture
+	fixture := timecode.Range{Start: timecode.Timecode(3723456), End: timecode.Timecode(3725006)}
+
+	// Exercise SUT
+	actual, err := timecode.ParseRange(fixture.Format(" --> ", ","))
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, fixture, actual)
+}
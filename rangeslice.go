@@ -0,0 +1,78 @@
+package timecode
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RangeSlice is a list of Ranges, e.g. the cues of a subtitle track. Its
+// methods assume that every Range in it is valid (see Range.IsValid).
+type RangeSlice []Range
+
+// Sort sorts rs in place by Start, and then by End for Ranges which start
+// together.
+func (rs RangeSlice) Sort() {
+	sort.Slice(rs, func(i, j int) bool {
+		if rs[i].Start != rs[j].Start {
+			return rs[i].Start < rs[j].Start
+		}
+		return rs[i].End < rs[j].End
+	})
+}
+
+// Merge returns a new, sorted RangeSlice in which Ranges that overlap or touch
+// are joined, so that it covers the same time as rs with as few Ranges as
+// possible. rs itself is unchanged.
+func (rs RangeSlice) Merge() RangeSlice {
+	sorted := make(RangeSlice, len(rs))
+	copy(sorted, rs)
+	sorted.Sort()
+
+	result := RangeSlice{}
+	for _, r := range sorted {
+		last := len(result) - 1
+		if last >= 0 && r.Start <= result[last].End {
+			result[last].End = Max(result[last].End, r.End)
+			continue
+		}
+		result = append(result, r)
+	}
+	return result
+}
+
+// Gaps returns the intervals between the start of the earliest Range and the
+// end of the latest Range in rs which no Range covers, in order. rs itself is
+// unchanged.
+func (rs RangeSlice) Gaps() []Range {
+	merged := rs.Merge()
+	result := []Range{}
+	for i := 1; i < len(merged); i++ {
+		result = append(result, Range{Start: merged[i-1].End, End: merged[i].Start})
+	}
+	return result
+}
+
+// Contains is true if any Range in rs contains t.
+func (rs RangeSlice) Contains(t Timecode) bool {
+	for _, r := range rs {
+		if r.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add appends r to rs. An error is returned, and rs is left unchanged, if r is
+// not valid or if it overlaps any Range already in rs.
+func (rs *RangeSlice) Add(r Range) error {
+	if !r.IsValid() {
+		return fmt.Errorf("cannot add invalid range [%s]", r)
+	}
+	for _, existing := range *rs {
+		if existing.Overlaps(r) {
+			return fmt.Errorf("[%s] overlaps existing range [%s]", r, existing)
+		}
+	}
+	*rs = append(*rs, r)
+	return nil
+}
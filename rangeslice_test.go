@@ -0,0 +1,169 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func seconds(start, end int64) timecode.Range {
+	return timecode.Range{Start: timecode.Timecode(start) * timecode.Second, End: timecode.Timecode(end) * timecode.Second}
+}
+
+func TestRangeSlice_Sort(t *testing.T) {
+	// Setup fixture
+	sut := timecode.RangeSlice{seconds(5, 6), seconds(1, 3), seconds(1, 2), seconds(0, 10)}
+
+	// Exercise SUT
+	sut.Sort()
+
+	// Verify result
+	assert.Equal(t, timecode.RangeSlice{seconds(0, 10), seconds(1, 2), seconds(1, 3), seconds(5, 6)}, sut)
+}
+
+func TestRangeSlice_Merge(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		rs       timecode.RangeSlice
+		expected timecode.RangeSlice
+	}{
+		{nil, timecode.RangeSlice{}},
+		{timecode.RangeSlice{seconds(1, 2)}, timecode.RangeSlice{seconds(1, 2)}},
+		{
+			timecode.RangeSlice{seconds(5, 6), seconds(1, 3), seconds(2, 4)},
+			timecode.RangeSlice{seconds(1, 4), seconds(5, 6)},
+		},
+		{
+			timecode.RangeSlice{seconds(1, 2), seconds(2, 3), seconds(4, 5)},
+			timecode.RangeSlice{seconds(1, 3), seconds(4, 5)},
+		},
+		{
+			timecode.RangeSlice{seconds(0, 10), seconds(1, 2), seconds(3, 4)},
+			timecode.RangeSlice{seconds(0, 10)},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			original := append(timecode.RangeSlice(nil), test.rs...)
+
+			// Exercise SUT
+			actual := test.rs.Merge()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, original, test.rs)
+		})
+	}
+}
+
+func TestRangeSlice_Gaps(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		rs       timecode.RangeSlice
+		expected []timecode.Range
+	}{
+		{nil, []timecode.Range{}},
+		{timecode.RangeSlice{seconds(1, 2)}, []timecode.Range{}},
+		{timecode.RangeSlice{seconds(1, 2), seconds(2, 3)}, []timecode.Range{}},
+		{
+			timecode.RangeSlice{seconds(7, 9), seconds(1, 3), seconds(2, 4)},
+			[]timecode.Range{seconds(4, 7)},
+		},
+		{
+			timecode.RangeSlice{seconds(0, 1), seconds(2, 3), seconds(5, 6)},
+			[]timecode.Range{seconds(1, 2), seconds(3, 5)},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.rs.Gaps()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRangeSlice_Contains(t *testing.T) {
+	// Setup fixture
+	sut := timecode.RangeSlice{seconds(1, 2), seconds(5, 6)}
+
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected bool
+	}{
+		{timecode.Zero, false},
+		{timecode.Second, true},
+		{2 * timecode.Second, false},
+		{5*timecode.Second + timecode.Millisecond, true},
+		{6 * timecode.Second, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := sut.Contains(test.timecode)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRangeSlice_Add_ValidCase(t *testing.T) {
+	// Setup fixture
+	var sut timecode.RangeSlice
+
+	// Exercise SUT
+	err1 := sut.Add(seconds(1, 2))
+	err2 := sut.Add(seconds(2, 3))
+	err3 := sut.Add(seconds(0, 1))
+
+	// Verify result
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.NoError(t, err3)
+	assert.Equal(t, timecode.RangeSlice{seconds(1, 2), seconds(2, 3), seconds(0, 1)}, sut)
+}
+
+func TestRangeSlice_Add_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r        timecode.Range
+		expected string
+	}{
+		{
+			seconds(3, 1),
+			"cannot add invalid range [00:00:03.000 --> 00:00:01.000]",
+		},
+		{
+			seconds(0, 2),
+			"[00:00:00.000 --> 00:00:02.000] overlaps existing range [00:00:01.000 --> 00:00:03.000]",
+		},
+		{
+			seconds(2, 6),
+			"[00:00:02.000 --> 00:00:06.000] overlaps existing range [00:00:01.000 --> 00:00:03.000]",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.RangeSlice{seconds(1, 3), seconds(5, 7)}
+
+			// Exercise SUT
+			err := sut.Add(test.r)
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, timecode.RangeSlice{seconds(1, 3), seconds(5, 7)}, sut)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package timecode
+
+import (
+	"bufio"
+	"context"
+	"io"
+)
+
+// TimecodeScanner reads Timecodes from an io.Reader line by line, without
+// loading it all into memory. Its API mirrors bufio.Scanner:
+//
+//	s := timecode.NewTimecodeScanner(r)
+//	for s.Scan() {
+//		fmt.Println(s.Timecode())
+//	}
+//	if err := s.Err(); err != nil {
+//		// handle err
+//	}
+//
+// By default, only the first timecode of each line is yielded (see Parse), and
+// lines without a timecode are skipped.
+type TimecodeScanner struct {
+	scanner *bufio.Scanner
+	all     bool
+	pending []Timecode
+	current Timecode
+}
+
+// ScannerOption configures a TimecodeScanner.
+type ScannerOption func(*TimecodeScanner)
+
+// WithAllTimecodes makes a TimecodeScanner yield every timecode on each line
+// in turn (see ParseAll), rather than only the first.
+func WithAllTimecodes() ScannerOption {
+	return func(s *TimecodeScanner) {
+		s.all = true
+	}
+}
+
+// NewTimecodeScanner returns a TimecodeScanner which reads from r.
+func NewTimecodeScanner(r io.Reader, opts ...ScannerOption) *TimecodeScanner {
+	s := &TimecodeScanner{scanner: bufio.NewScanner(r)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Scan advances to the next timecode, which is then available through
+// Timecode. It returns false when there are no more timecodes, either because
+// the end of the input was reached or because of an error, which is available
+// through Err.
+func (s *TimecodeScanner) Scan() bool {
+	for len(s.pending) == 0 {
+		if !s.scanner.Scan() {
+			s.current = Zero
+			return false
+		}
+		s.pending = s.timecodes(s.scanner.Text())
+	}
+
+	s.current = s.pending[0]
+	s.pending = s.pending[1:]
+	return true
+}
+
+// Timecode returns the most recent timecode found by Scan.
+func (s *TimecodeScanner) Timecode() Timecode {
+	return s.current
+}
+
+// Err returns the first non-EOF error that was encountered reading the input.
+func (s *TimecodeScanner) Err() error {
+	return s.scanner.Err()
+}
+
+func (s *TimecodeScanner) timecodes(line string) []Timecode {
+	if s.all {
+		return ParseAll(line)
+	}
+	t, err := Parse(line)
+	if err != nil {
+		return nil
+	}
+	return []Timecode{t}
+}
+
+// ParseAllCtx reads r line by line in a new goroutine, sending every timecode
+// found (see ParseAll) on the first channel. If reading fails, or ctx is done
+// before the end of r is reached, then the error is sent on the second
+// channel. Both channels are closed when the goroutine finishes.
+//
+// The caller should receive from the first channel until it is closed, or
+// else cancel ctx, so that the goroutine can finish. The error channel is
+// buffered, so it need not be received from. Note that ctx cannot interrupt a
+// read of r which is blocked.
+func ParseAllCtx(ctx context.Context, r io.Reader) (<-chan Timecode, <-chan error) {
+	timecodes := make(chan Timecode)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(timecodes)
+
+		s := NewTimecodeScanner(r, WithAllTimecodes())
+		for {
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+			if !s.Scan() {
+				break
+			}
+			select {
+			case timecodes <- s.Timecode():
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			errs <- err
+		}
+	}()
+
+	return timecodes, errs
+}
@@ -0,0 +1,137 @@
+package timecode_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+const scannerInput = "1\n" +
+	"00:00:01,000 --> 00:00:02,000\n" +
+	"Hello there!\n" +
+	"\n" +
+	"2\n" +
+	"00:00:03,000 --> 00:00:04,000\n" +
+	"General Kenobi!"
+
+func TestTimecodeScanner_FirstPerLine(t *testing.T) {
+	// Setup fixture
+	sut := timecode.NewTimecodeScanner(strings.NewReader(scannerInput))
+
+	// Exercise SUT
+	var actual []timecode.Timecode
+	for sut.Scan() {
+		actual = append(actual, sut.Timecode())
+	}
+
+	// Verify result
+	assert.NoError(t, sut.Err())
+	assert.Equal(t, []timecode.Timecode{timecode.Second, 3 * timecode.Second}, actual)
+	assert.Equal(t, timecode.Zero, sut.Timecode())
+}
+
+func TestTimecodeScanner_AllTimecodes(t *testing.T) {
+	// Setup fixture
+	sut := timecode.NewTimecodeScanner(strings.NewReader(scannerInput), timecode.WithAllTimecodes())
+
+	// Exercise SUT
+	var actual []timecode.Timecode
+	for sut.Scan() {
+		actual = append(actual, sut.Timecode())
+	}
+
+	// Verify result
+	assert.NoError(t, sut.Err())
+	assert.Equal(t, []timecode.Timecode{
+		timecode.Second, 2 * timecode.Second, 3 * timecode.Second, 4 * timecode.Second,
+	}, actual)
+}
+
+func TestTimecodeScanner_Empty(t *testing.T) {
+	// Setup fixture
+	sut := timecode.NewTimecodeScanner(strings.NewReader(""))
+
+	// Exercise SUT
+	actual := sut.Scan()
+
+	// Verify result
+	assert.False(t, actual)
+	assert.NoError(t, sut.Err())
+}
+
+func TestTimecodeScanner_ReaderError(t *testing.T) {
+	// Setup fixture
+	sut := timecode.NewTimecodeScanner(failingReader{})
+
+	// Exercise SUT
+	actual := sut.Scan()
+
+	// Verify result
+	assert.False(t, actual)
+	assert.EqualError(t, sut.Err(), "read failed")
+}
+
+func TestParseAllCtx(t *testing.T) {
+	// Exercise SUT
+	timecodes, errs := timecode.ParseAllCtx(context.Background(), strings.NewReader(scannerInput))
+
+	// Verify result
+	var actual []timecode.Timecode
+	for tc := range timecodes {
+		actual = append(actual, tc)
+	}
+	assert.Equal(t, []timecode.Timecode{
+		timecode.Second, 2 * timecode.Second, 3 * timecode.Second, 4 * timecode.Second,
+	}, actual)
+	assert.NoError(t, <-errs)
+}
+
+func TestParseAllCtx_ReaderError(t *testing.T) {
+	// Exercise SUT
+	timecodes, errs := timecode.ParseAllCtx(context.Background(), failingReader{})
+
+	// Verify result
+	_, ok := <-timecodes
+	assert.False(t, ok)
+	assert.EqualError(t, <-errs, "read failed")
+}
+
+func TestParseAllCtx_CancelledBeforeStart(t *testing.T) {
+	// Setup fixture
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Exercise SUT
+	timecodes, errs := timecode.ParseAllCtx(ctx, strings.NewReader(scannerInput))
+
+	// Verify result
+	_, ok := <-timecodes
+	assert.False(t, ok)
+	assert.Equal(t, context.Canceled, <-errs)
+}
+
+func TestParseAllCtx_CancelledWhileSending(t *testing.T) {
+	// Setup fixture
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Exercise SUT
+	timecodes, errs := timecode.ParseAllCtx(ctx, strings.NewReader(scannerInput))
+	first := <-timecodes
+	cancel()
+
+	// Verify result
+	assert.Equal(t, timecode.Second, first)
+	assert.Equal(t, context.Canceled, <-errs)
+	_, ok := <-timecodes
+	assert.False(t, ok)
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
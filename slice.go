@@ -0,0 +1,179 @@
+package timecode
+
+import (
+	"sort"
+)
+
+// Sort sorts s in place, earliest first.
+func Sort(s []Timecode) {
+	sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+}
+
+// SortDesc sorts s in place, latest first.
+func SortDesc(s []Timecode) {
+	sort.Slice(s, func(i, j int) bool { return s[i] > s[j] })
+}
+
+// IsSorted is true if s is sorted earliest first.
+func IsSorted(s []Timecode) bool {
+	return sort.SliceIsSorted(s, func(i, j int) bool { return s[i] < s[j] })
+}
+
+// Deduplicate returns a new slice holding each distinct Timecode of s once,
+// sorted earliest first. s itself is not modified.
+func Deduplicate(s []Timecode) []Timecode {
+	sorted := make([]Timecode, len(s))
+	copy(sorted, s)
+	Sort(sorted)
+
+	result := sorted[:0]
+	for i, t := range sorted {
+		if i == 0 || t != sorted[i-1] {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// BinarySearch searches for target in s, which must be sorted earliest first.
+// It returns the index of target (the first, if there are several) and true if
+// found, or the index at which target would need to be inserted to keep s
+// sorted and false otherwise.
+func BinarySearch(s []Timecode, target Timecode) (int, bool) {
+	i := sort.Search(len(s), func(i int) bool { return s[i] >= target })
+	return i, i < len(s) && s[i] == target
+}
+
+// Closest returns the element of s nearest to target, and its index. Ties are
+// broken in favour of the earlier index, and an empty s gives Zero and -1.
+//
+// s need not be sorted, and is scanned in full. If s is sorted, BinarySearch
+// followed by a comparison of the neighbours either side of the returned index
+// gives the same answer in O(log n).
+func Closest(s []Timecode, target Timecode) (Timecode, int) {
+	if len(s) == 0 {
+		return Zero, -1
+	}
+
+	best := 0
+	for i := 1; i < len(s); i++ {
+		if AbsDiff(s[i], target) < AbsDiff(s[best], target) {
+			best = i
+		}
+	}
+	return s[best], best
+}
+
+// Shift returns a new slice with each element of s moved by offset, which may
+// be negative.
+func Shift(s []Timecode, offset Timecode) []Timecode {
+	result := make([]Timecode, len(s))
+	for i, t := range s {
+		result[i] = t + offset
+	}
+	return result
+}
+
+// ShiftInPlace is like Shift, but modifies s instead of allocating a new
+// slice.
+func ShiftInPlace(s []Timecode, offset Timecode) {
+	for i := range s {
+		s[i] += offset
+	}
+}
+
+// Map returns a new slice holding the result of applying f to each element of
+// s.
+func Map(s []Timecode, f func(Timecode) Timecode) []Timecode {
+	result := make([]Timecode, len(s))
+	for i, t := range s {
+		result[i] = f(t)
+	}
+	return result
+}
+
+// MapInPlace is like Map, but replaces each element of s instead of allocating
+// a new slice.
+func MapInPlace(s []Timecode, f func(Timecode) Timecode) {
+	for i, t := range s {
+		s[i] = f(t)
+	}
+}
+
+// Filter returns a new slice holding only the elements of s for which pred is
+// true, in their original order.
+func Filter(s []Timecode, pred func(Timecode) bool) []Timecode {
+	result := make([]Timecode, 0, len(s))
+	for _, t := range s {
+		if pred(t) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// Sum returns the total of ts, e.g. the running time of a list of segment
+// durations. No arguments gives Zero.
+func Sum(ts ...Timecode) Timecode {
+	total := Zero
+	for _, t := range ts {
+		total += t
+	}
+	return total
+}
+
+// Average returns the mean of ts, truncated toward zero to the millisecond. No
+// arguments gives Zero.
+//
+// Each element is divided before summing, so the result does not overflow
+// even if Sum(ts...) would.
+func Average(ts ...Timecode) Timecode {
+	n := Timecode(len(ts))
+	if n == 0 {
+		return Zero
+	}
+
+	var quotients, remainders Timecode
+	for _, t := range ts {
+		quotients += t / n
+		remainders += t % n
+	}
+	result := quotients + remainders/n
+
+	// Correct for truncation when the quotients and remainders differ in sign
+	rem := remainders % n
+	if result > Zero && rem < Zero {
+		result--
+	} else if result < Zero && rem > Zero {
+		result++
+	}
+	return result
+}
+
+// Sequence returns n Timecodes, starting at start and each step after the
+// previous. step may be negative (counting down) or Zero (n copies of start).
+// If n is not positive, the result is empty.
+func Sequence(start, step Timecode, n int) []Timecode {
+	if n <= 0 {
+		return []Timecode{}
+	}
+	result := make([]Timecode, n)
+	for i := range result {
+		result[i] = start + Timecode(i)*step
+	}
+	return result
+}
+
+// Accumulate returns the running totals of durations, so that element i is
+// the sum of durations[0] through durations[i]. E.g. a list of chapter lengths
+// gives the end position of each chapter. The result has the same length as
+// durations; the input is not validated.
+func Accumulate(durations []Timecode) []Timecode {
+	result := make([]Timecode, len(durations))
+	total := Zero
+	for i, d := range durations {
+		total += d
+		result[i] = total
+	}
+	return result
+}
@@ -0,0 +1,366 @@
+package timecode_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{timecode.Minute, -timecode.Hour, timecode.Zero, -timecode.Millisecond, timecode.Second}
+
+	// Exercise SUT
+	timecode.Sort(fixture)
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{-timecode.Hour, -timecode.Millisecond, timecode.Zero, timecode.Second, timecode.Minute}, fixture)
+}
+
+func TestSortDesc(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{timecode.Minute, -timecode.Hour, timecode.Zero, -timecode.Millisecond, timecode.Second}
+
+	// Exercise SUT
+	timecode.SortDesc(fixture)
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{timecode.Minute, timecode.Second, timecode.Zero, -timecode.Millisecond, -timecode.Hour}, fixture)
+}
+
+func TestIsSorted(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		s        []timecode.Timecode
+		expected bool
+	}{
+		{nil, true},
+		{[]timecode.Timecode{timecode.Second}, true},
+		{[]timecode.Timecode{-timecode.Hour, -timecode.Second, timecode.Zero, timecode.Second}, true},
+		{[]timecode.Timecode{timecode.Second, timecode.Second}, true},
+		{[]timecode.Timecode{-timecode.Second, -timecode.Hour}, false},
+		{[]timecode.Timecode{timecode.Second, -timecode.Second}, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.IsSorted(test.s)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		s        []timecode.Timecode
+		expected []timecode.Timecode
+	}{
+		{nil, []timecode.Timecode{}},
+		{[]timecode.Timecode{timecode.Second}, []timecode.Timecode{timecode.Second}},
+		{
+			[]timecode.Timecode{timecode.Second, -timecode.Second, timecode.Second, timecode.Zero, -timecode.Second},
+			[]timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			original := append([]timecode.Timecode(nil), test.s...)
+
+			// Exercise SUT
+			actual := timecode.Deduplicate(test.s)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, original, test.s)
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{-timecode.Minute, timecode.Zero, timecode.Second, timecode.Second, timecode.Minute}
+
+	// Setup expectations
+	var tests = []struct {
+		s             []timecode.Timecode
+		target        timecode.Timecode
+		expectedIndex int
+		expectedFound bool
+	}{
+		{nil, timecode.Second, 0, false},
+		{fixture, -timecode.Hour, 0, false},
+		{fixture, -timecode.Minute, 0, true},
+		{fixture, -timecode.Second, 1, false},
+		{fixture, timecode.Zero, 1, true},
+		{fixture, timecode.Second, 2, true},
+		{fixture, 2 * timecode.Second, 4, false},
+		{fixture, timecode.Minute, 4, true},
+		{fixture, timecode.Hour, 5, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualIndex, actualFound := timecode.BinarySearch(test.s, test.target)
+
+			// Verify result
+			assert.Equal(t, test.expectedIndex, actualIndex)
+			assert.Equal(t, test.expectedFound, actualFound)
+		})
+	}
+}
+
+func TestClosest(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{timecode.Minute, -timecode.Second, 3 * timecode.Second, timecode.Second, timecode.Second}
+
+	// Setup expectations
+	var tests = []struct {
+		s             []timecode.Timecode
+		target        timecode.Timecode
+		expected      timecode.Timecode
+		expectedIndex int
+	}{
+		{nil, timecode.Second, timecode.Zero, -1},
+		{[]timecode.Timecode{}, timecode.Second, timecode.Zero, -1},
+		{fixture, timecode.Hour, timecode.Minute, 0},
+		{fixture, -timecode.Hour, -timecode.Second, 1},
+		{fixture, timecode.Zero, -timecode.Second, 1},
+		{fixture, 1100 * timecode.Millisecond, timecode.Second, 3},
+		{fixture, 2 * timecode.Second, 3 * timecode.Second, 2},
+		{fixture, 2500 * timecode.Millisecond, 3 * timecode.Second, 2},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, actualIndex := timecode.Closest(test.s, test.target)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.expectedIndex, actualIndex)
+		})
+	}
+}
+
+func TestShift(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second, 3 * timecode.Second}
+
+	// Exercise SUT
+	actual := timecode.Shift(fixture, -2*timecode.Second)
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{-3 * timecode.Second, -2 * timecode.Second, -timecode.Second, timecode.Second}, actual)
+	assert.Equal(t, []timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second, 3 * timecode.Second}, fixture)
+}
+
+func TestShift_Empty(t *testing.T) {
+	// Exercise SUT
+	actual := timecode.Shift(nil, timecode.Second)
+
+	// Verify result
+	assert.Empty(t, actual)
+}
+
+func TestShiftInPlace(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second}
+
+	// Exercise SUT
+	timecode.ShiftInPlace(fixture, timecode.Second)
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{timecode.Zero, timecode.Second, 2 * timecode.Second}, fixture)
+}
+
+func TestMap(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second}
+
+	// Exercise SUT
+	actual := timecode.Map(fixture, func(t timecode.Timecode) timecode.Timecode {
+		return t.Scale(2)
+	})
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{-2 * timecode.Second, timecode.Zero, 2 * timecode.Second}, actual)
+	assert.Equal(t, []timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second}, fixture)
+}
+
+func TestMap_Empty(t *testing.T) {
+	// Exercise SUT
+	actual := timecode.Map(nil, timecode.Timecode.Abs)
+
+	// Verify result
+	assert.NotNil(t, actual)
+	assert.Empty(t, actual)
+}
+
+func TestMapInPlace(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{-timecode.Second, timecode.Zero, timecode.Second}
+
+	// Exercise SUT
+	timecode.MapInPlace(fixture, timecode.Timecode.Abs)
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{timecode.Second, timecode.Zero, timecode.Second}, fixture)
+}
+
+func TestFilter(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{15 * timecode.Minute, 5 * timecode.Minute, 10 * timecode.Minute, 25 * timecode.Minute, 20 * timecode.Minute}
+	start, end := 10*timecode.Minute, 20*timecode.Minute
+
+	// Exercise SUT
+	actual := timecode.Filter(fixture, func(tc timecode.Timecode) bool {
+		return tc >= start && tc <= end
+	})
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{15 * timecode.Minute, 10 * timecode.Minute, 20 * timecode.Minute}, actual)
+}
+
+func TestFilter_NoneMatch(t *testing.T) {
+	// Setup fixture
+	fixture := []timecode.Timecode{timecode.Second, timecode.Minute}
+
+	// Exercise SUT
+	actual := timecode.Filter(fixture, timecode.Timecode.IsNegative)
+
+	// Verify result
+	assert.NotNil(t, actual)
+	assert.Empty(t, actual)
+}
+
+func TestSum(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		ts       []timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{nil, timecode.Zero},
+		{[]timecode.Timecode{timecode.Second}, timecode.Second},
+		{[]timecode.Timecode{timecode.Second, timecode.Minute, timecode.Hour}, timecode.Timecode(3661000)},
+		{[]timecode.Timecode{timecode.Second, -timecode.Minute}, -59 * timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.Sum(test.ts...)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestAverage(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		ts       []timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{nil, timecode.Zero},
+		{[]timecode.Timecode{timecode.Second}, timecode.Second},
+		{[]timecode.Timecode{timecode.Second, 3 * timecode.Second}, 2 * timecode.Second},
+		{[]timecode.Timecode{timecode.Zero, timecode.Millisecond}, timecode.Zero},
+		{[]timecode.Timecode{1, 1, 2}, 1},
+		{[]timecode.Timecode{2, 2, 2, 1}, 1},
+		{[]timecode.Timecode{-timecode.Second, -3 * timecode.Second}, -2 * timecode.Second},
+		{[]timecode.Timecode{-timecode.Second, timecode.Second}, timecode.Zero},
+		{[]timecode.Timecode{-1, 2}, timecode.Zero},
+		{[]timecode.Timecode{1, -2}, timecode.Zero},
+		{[]timecode.Timecode{-1, 4}, timecode.Millisecond},
+		{[]timecode.Timecode{1, -4}, -timecode.Millisecond},
+		{[]timecode.Timecode{-5, 3, 3}, timecode.Zero},
+		{[]timecode.Timecode{math.MaxInt64, math.MaxInt64 - 2}, math.MaxInt64 - 1},
+		{[]timecode.Timecode{math.MinInt64, math.MinInt64 + 2}, math.MinInt64 + 1},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.Average(test.ts...)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSequence(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		start    timecode.Timecode
+		step     timecode.Timecode
+		n        int
+		expected []timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Second, 0, []timecode.Timecode{}},
+		{timecode.Zero, timecode.Second, -1, []timecode.Timecode{}},
+		{timecode.Minute, timecode.Second, 1, []timecode.Timecode{timecode.Minute}},
+		{
+			timecode.Zero, 10 * timecode.Minute, 4,
+			[]timecode.Timecode{timecode.Zero, 10 * timecode.Minute, 20 * timecode.Minute, 30 * timecode.Minute},
+		},
+		{
+			timecode.Second, -timecode.Second, 3,
+			[]timecode.Timecode{timecode.Second, timecode.Zero, -timecode.Second},
+		},
+		{
+			timecode.Second, timecode.Zero, 3,
+			[]timecode.Timecode{timecode.Second, timecode.Second, timecode.Second},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.Sequence(test.start, test.step, test.n)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestAccumulate(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		durations []timecode.Timecode
+		expected  []timecode.Timecode
+	}{
+		{nil, []timecode.Timecode{}},
+		{[]timecode.Timecode{timecode.Minute}, []timecode.Timecode{timecode.Minute}},
+		{
+			[]timecode.Timecode{timecode.Minute, 2 * timecode.Minute, 30 * timecode.Second},
+			[]timecode.Timecode{timecode.Minute, 3 * timecode.Minute, 3*timecode.Minute + 30*timecode.Second},
+		},
+		{
+			[]timecode.Timecode{timecode.Second, -3 * timecode.Second, timecode.Second},
+			[]timecode.Timecode{timecode.Second, -2 * timecode.Second, -timecode.Second},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.Accumulate(test.durations)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
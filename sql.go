@@ -0,0 +1,36 @@
+package timecode
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// Check we implement the interfaces
+var _ driver.Valuer = Zero
+var _ sql.Scanner = (*Timecode)(nil)
+
+// Value stores t in the FormatDot format, e.g. "01:02:03.456".
+func (t Timecode) Value() (driver.Value, error) {
+	return t.FormatDot(), nil
+}
+
+// Scan reads a Timecode from a database value. Strings and byte slices are
+// decoded as per UnmarshalText (so any Value may be read back), integers are
+// taken as a count of milliseconds, and NULL gives Zero. t is left unchanged
+// if an error is returned.
+func (t *Timecode) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Zero
+	case int64:
+		*t = Timecode(v)
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan [%v] of type %T into a Timecode", src, src)
+	}
+	return nil
+}
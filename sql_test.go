@@ -0,0 +1,100 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimecode_Value(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-3723456)
+
+	// Exercise SUT
+	actual, err := sut.Value()
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, "-01:02:03.456", actual)
+}
+
+func TestTimecode_Scan_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		src      interface{}
+		expected timecode.Timecode
+	}{
+		{nil, timecode.Zero},
+		{int64(3723456), timecode.Timecode(3723456)},
+		{int64(-1), -timecode.Millisecond},
+		{"01:02:03.456", timecode.Timecode(3723456)},
+		{[]byte("-01:02:03,456"), timecode.Timecode(-3723456)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Hour
+
+			// Exercise SUT
+			err := sut.Scan(test.src)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, sut)
+		})
+	}
+}
+
+func TestTimecode_ValueScan_RoundTrip(t *testing.T) {
+	// Setup expectations
+	var tests = []timecode.Timecode{
+		timecode.Timecode(-3723456),
+		24 * timecode.Hour,
+		25 * timecode.Hour,
+		123*timecode.Hour + 45*timecode.Minute + 12*timecode.Second,
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			v, err := test.Value()
+			assert.NoError(t, err)
+			var actual, actualBytes timecode.Timecode
+			err = actual.Scan(v)
+			errBytes := actualBytes.Scan([]byte(v.(string)))
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.NoError(t, errBytes)
+			assert.Equal(t, test, actual)
+			assert.Equal(t, test, actualBytes)
+		})
+	}
+}
+
+func TestTimecode_Scan_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []interface{}{
+		"not.a.timecode",
+		[]byte("00:00"),
+		1.5,
+		true,
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Setup fixture
+			sut := timecode.Hour
+
+			// Exercise SUT
+			err := sut.Scan(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Hour, sut)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package timecode
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SRTBlock is a single numbered subtitle in a SubRip (.srt) file.
+type SRTBlock struct {
+	// Index is the sequence number of the block, usually counting from 1.
+	Index int
+	// Range is when the subtitle is shown.
+	Range Range
+	// Lines holds the subtitle text, one element per line.
+	Lines []string
+}
+
+// srtLineRegex matches a SubRip cue timing line, capturing the hours,
+// minutes, seconds, and milliseconds of the start and end timecodes.
+var srtLineRegex = regexp.MustCompile(
+	`^([01]\d|2[0123]):([012345]\d):([012345]\d),(\d{3}) --> ([01]\d|2[0123]):([012345]\d):([012345]\d),(\d{3})$`)
+
+// ParseSRTLine extracts the start and end Timecodes from a single SubRip cue
+// timing line, e.g. "00:01:02,003 --> 00:01:04,005". Surrounding whitespace
+// (including a trailing carriage return) is ignored, but anything else which
+// does not conform to the line format will return an error.
+func ParseSRTLine(line string) (start, end Timecode, err error) {
+	m := srtLineRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if len(m) == 0 {
+		return Zero, Zero, fmt.Errorf("[%s] is not an SRT timing line", line)
+	}
+
+	start = FromParams(false,
+		parseNumber(m, 1), parseNumber(m, 2), parseNumber(m, 3), parseNumber(m, 4))
+	end = FromParams(false,
+		parseNumber(m, 5), parseNumber(m, 6), parseNumber(m, 7), parseNumber(m, 8))
+	return start, end, nil
+}
+
+// ParseSRTFile reads a complete SubRip file from r. Blocks are separated by one
+// or more blank lines, and each consists of an index line, a timing line (see
+// ParseSRTLine), and zero or more lines of text. A leading byte order mark and
+// carriage returns are ignored.
+//
+// An error identifying the offending line is returned if any block is
+// malformed.
+func ParseSRTFile(r io.Reader) ([]SRTBlock, error) {
+	scanner := bufio.NewScanner(r)
+	blocks := []SRTBlock{}
+	var current *SRTBlock
+	// 0 expects an index line, 1 a timing line, and 2 text lines
+	state := 0
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		if strings.TrimSpace(line) == "" {
+			if state == 1 {
+				return nil, fmt.Errorf("line %d: block [%d] has no timing line", lineNumber, current.Index)
+			}
+			state = 0
+			continue
+		}
+
+		switch state {
+		case 0:
+			index, err := strconv.Atoi(strings.TrimSpace(line))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: [%s] is not an SRT block index", lineNumber, line)
+			}
+			blocks = append(blocks, SRTBlock{Index: index})
+			current = &blocks[len(blocks)-1]
+			state = 1
+		case 1:
+			start, end, err := ParseSRTLine(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			current.Range = Range{Start: start, End: end}
+			state = 2
+		case 2:
+			current.Lines = append(current.Lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if state == 1 {
+		return nil, fmt.Errorf("line %d: block [%d] has no timing line", lineNumber, current.Index)
+	}
+	return blocks, nil
+}
+
+// FormatSRTFile writes blocks to w as a SubRip file, e.g.
+//
+//	1
+//	00:00:01,000 --> 00:00:02,500
+//	Hello there!
+//
+// An error is returned if either end of a block's Range is not IsValid, since
+// SubRip timecodes cannot be negative or exceed 23:59:59,999.
+func FormatSRTFile(blocks []SRTBlock, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for i, block := range blocks {
+		if err := block.Range.Start.Validate(); err != nil {
+			return fmt.Errorf("block [%d]: %w", block.Index, err)
+		}
+		if err := block.Range.End.Validate(); err != nil {
+			return fmt.Errorf("block [%d]: %w", block.Index, err)
+		}
+
+		if i > 0 {
+			bw.WriteString("\n")
+		}
+		bw.WriteString(strconv.Itoa(block.Index))
+		bw.WriteString("\n")
+		bw.WriteString(block.Range.Format(" --> ", ","))
+		bw.WriteString("\n")
+		for _, line := range block.Lines {
+			bw.WriteString(line)
+			bw.WriteString("\n")
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,239 @@
+package timecode_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSRTLine_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		line          string
+		expectedStart timecode.Timecode
+		expectedEnd   timecode.Timecode
+	}{
+		{
+			"00:00:00,000 --> 00:00:00,000",
+			timecode.Zero,
+			timecode.Zero,
+		},
+		{
+			"00:00:01,000 --> 00:00:02,500",
+			timecode.Second,
+			2*timecode.Second + 500*timecode.Millisecond,
+		},
+		{
+			"01:02:03,456 --> 23:59:59,999",
+			timecode.Timecode(3723456),
+			timecode.Timecode(86399999),
+		},
+		{
+			"  00:01:00,000 --> 00:02:00,000\r\n",
+			timecode.Minute,
+			2 * timecode.Minute,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualStart, actualEnd, err := timecode.ParseSRTLine(test.line)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStart, actualStart)
+			assert.Equal(t, test.expectedEnd, actualEnd)
+		})
+	}
+}
+
+func TestParseSRTLine_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []string{
+		"",
+		"not a timing line",
+		"00:00:01,000",
+		"00:00:01.000 --> 00:00:02.000",
+		"00:00:01,000 -> 00:00:02,000",
+		"00:00:01,000 --> 00:00:02",
+		"-00:00:01,000 --> 00:00:02,000",
+		"1\n00:00:01,000 --> 00:00:02,000",
+		"00:00:01,000 --> 00:00:02,000 X1:40",
+		"24:00:00,000 --> 24:00:01,000",
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualStart, actualEnd, err := timecode.ParseSRTLine(test)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, timecode.Zero, actualStart)
+			assert.Equal(t, timecode.Zero, actualEnd)
+		})
+	}
+}
+
+func TestParseSRTFile_ValidCase(t *testing.T) {
+	// Setup fixture
+	input := "\uFEFF1\r\n" +
+		"00:00:01,000 --> 00:00:02,500\r\n" +
+		"Hello there!\r\n" +
+		"\r\n" +
+		"2\n" +
+		"00:00:03,000 --> 00:00:04,000\n" +
+		"- General Kenobi!\n" +
+		"- You are a bold one.\n" +
+		"\n\n" +
+		"3\n" +
+		"00:00:05,000 --> 00:00:06,000\n"
+
+	// Setup expectations
+	expected := []timecode.SRTBlock{
+		{
+			Index: 1,
+			Range: timecode.Range{Start: timecode.Second, End: 2*timecode.Second + 500*timecode.Millisecond},
+			Lines: []string{"Hello there!"},
+		},
+		{
+			Index: 2,
+			Range: timecode.Range{Start: 3 * timecode.Second, End: 4 * timecode.Second},
+			Lines: []string{"- General Kenobi!", "- You are a bold one."},
+		},
+		{
+			Index: 3,
+			Range: timecode.Range{Start: 5 * timecode.Second, End: 6 * timecode.Second},
+		},
+	}
+
+	// Exercise SUT
+	actual, err := timecode.ParseSRTFile(strings.NewReader(input))
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestParseSRTFile_Empty(t *testing.T) {
+	// Exercise SUT
+	actual, err := timecode.ParseSRTFile(strings.NewReader("\n\n"))
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, []timecode.SRTBlock{}, actual)
+}
+
+func TestParseSRTFile_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		input    string
+		expected string
+	}{
+		{
+			"one\n00:00:01,000 --> 00:00:02,000\n",
+			"line 1: [one] is not an SRT block index",
+		},
+		{
+			"1\n00:00:01.000 --> 00:00:02.000\n",
+			"line 2: [00:00:01.000 --> 00:00:02.000] is not an SRT timing line",
+		},
+		{
+			"1\n\n00:00:01,000 --> 00:00:02,000\n",
+			"line 2: block [1] has no timing line",
+		},
+		{
+			"1\n00:00:01,000 --> 00:00:02,000\n\n2",
+			"line 4: block [2] has no timing line",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseSRTFile(strings.NewReader(test.input))
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Nil(t, actual)
+		})
+	}
+}
+
+func TestFormatSRTFile_ValidCase(t *testing.T) {
+	// Setup fixture
+	blocks := []timecode.SRTBlock{
+		{
+			Index: 1,
+			Range: timecode.Range{Start: timecode.Second, End: 2*timecode.Second + 500*timecode.Millisecond},
+			Lines: []string{"Hello there!"},
+		},
+		{
+			Index: 2,
+			Range: timecode.Range{Start: timecode.Hour, End: timecode.Hour + timecode.Second},
+			Lines: []string{"- General Kenobi!", "- You are a bold one."},
+		},
+	}
+	var buf bytes.Buffer
+
+	// Exercise SUT
+	err := timecode.FormatSRTFile(blocks, &buf)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, "1\n"+
+		"00:00:01,000 --> 00:00:02,500\n"+
+		"Hello there!\n"+
+		"\n"+
+		"2\n"+
+		"01:00:00,000 --> 01:00:01,000\n"+
+		"- General Kenobi!\n"+
+		"- You are a bold one.\n", buf.String())
+
+	roundTrip, err := timecode.ParseSRTFile(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, blocks, roundTrip)
+}
+
+func TestFormatSRTFile_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		r        timecode.Range
+		expected string
+	}{
+		{
+			timecode.Range{Start: -timecode.Second, End: timecode.Second},
+			"block [7]: [-00:00:01.000] is outside of the valid timecode range [00:00:00.000, 23:59:59.999]",
+		},
+		{
+			timecode.Range{Start: timecode.Second, End: 24 * timecode.Hour},
+			"block [7]: [24:00:00.000] is outside of the valid timecode range [00:00:00.000, 23:59:59.999]",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			err := timecode.FormatSRTFile([]timecode.SRTBlock{{Index: 7, Range: test.r}}, &bytes.Buffer{})
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestFormatSRTFile_WriterError(t *testing.T) {
+	// Setup fixture
+	blocks := []timecode.SRTBlock{{Index: 1, Range: timecode.Range{End: timecode.Second}}}
+
+	// Exercise SUT
+	err := timecode.FormatSRTFile(blocks, failingWriter{})
+
+	// Verify result
+	assert.Error(t, err)
+}
@@ -0,0 +1,45 @@
+package timecode
+
+import (
+	"time"
+)
+
+// FromDuration converts d into a Timecode, truncating toward zero to
+// millisecond precision.
+func FromDuration(d time.Duration) Timecode {
+	return Timecode(d / time.Millisecond)
+}
+
+// ToDuration converts t into a time.Duration.
+func (t Timecode) ToDuration() time.Duration {
+	return time.Duration(t) * time.Millisecond
+}
+
+// Time returns the wall-clock time that is t after base, e.g. the moment a
+// subtitle should appear given the time playback started.
+func (t Timecode) Time(base time.Time) time.Time {
+	return base.Add(t.ToDuration())
+}
+
+// FromTime returns the Timecode of t relative to base (negative if t is before
+// base), truncating toward zero to millisecond precision. It is the inverse of
+// Timecode.Time.
+func FromTime(t, base time.Time) Timecode {
+	return FromDuration(t.Sub(base))
+}
+
+// FormatUTC formats the wall-clock UTC time that is t after epoch, e.g.
+// "13:02:03.456". If that time falls on a different UTC day to epoch, then the
+// date is included as well, e.g. "2020-03-05T01:02:03.456Z" (as per
+// time.RFC3339, but always with milliseconds).
+func (t Timecode) FormatUTC(epoch time.Time) string {
+	start := epoch.UTC()
+	result := start.Add(t.ToDuration())
+
+	sy, sm, sd := start.Date()
+	ry, rm, rd := result.Date()
+	if sy != ry || sm != rm || sd != rd {
+		return result.Format("2006-01-02T15:04:05.000Z07:00")
+	}
+	return result.Format("15:04:05.000")
+}
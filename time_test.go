@@ -0,0 +1,176 @@
+package timecode_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromDuration(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		duration time.Duration
+		expected timecode.Timecode
+	}{
+		{0, timecode.Zero},
+		{time.Millisecond, timecode.Millisecond},
+		{time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond, timecode.Timecode(3723456)},
+		{-(time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond), timecode.Timecode(-3723456)},
+		{time.Millisecond - time.Nanosecond, timecode.Zero},
+		{time.Second + 999*time.Microsecond, timecode.Second},
+		{-time.Second - 999*time.Microsecond, -timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.FromDuration(test.duration)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_ToDuration(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected time.Duration
+	}{
+		{timecode.Zero, 0},
+		{timecode.Millisecond, time.Millisecond},
+		{timecode.Timecode(3723456), time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond},
+		{timecode.Timecode(-3723456), -(time.Hour + 2*time.Minute + 3*time.Second + 456*time.Millisecond)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.ToDuration()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.timecode, timecode.FromDuration(actual))
+		})
+	}
+}
+
+func TestTimecode_Time(t *testing.T) {
+	// Setup fixture
+	base := time.Date(2020, time.March, 4, 12, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected time.Time
+	}{
+		{timecode.Zero, base},
+		{timecode.Timecode(3723456), time.Date(2020, time.March, 4, 13, 2, 3, 456000000, time.UTC)},
+		{-timecode.Hour, time.Date(2020, time.March, 4, 11, 0, 0, 0, time.UTC)},
+		{12 * timecode.Hour, time.Date(2020, time.March, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Time(base)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.timecode, timecode.FromTime(actual, base))
+		})
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	// Setup fixture
+	base := time.Date(2020, time.March, 4, 12, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	var tests = []struct {
+		t        time.Time
+		expected timecode.Timecode
+	}{
+		{base, timecode.Zero},
+		{base.Add(time.Second + 999*time.Microsecond), timecode.Second},
+		{base.Add(-time.Second - 999*time.Microsecond), -timecode.Second},
+		{base.In(time.FixedZone("UTC+2", 2*60*60)).Add(time.Minute), timecode.Minute},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.FromTime(test.t, base)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatUTC(t *testing.T) {
+	// Setup fixture
+	epoch := time.Date(2020, time.March, 4, 12, 0, 0, 0, time.UTC)
+
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		epoch    time.Time
+		expected string
+	}{
+		{
+			timecode.Zero,
+			epoch,
+			"12:00:00.000",
+		},
+		{
+			timecode.Timecode(3723456),
+			epoch,
+			"13:02:03.456",
+		},
+		{
+			-timecode.Hour,
+			epoch,
+			"11:00:00.000",
+		},
+		{
+			11*timecode.Hour + 59*timecode.Minute + 59*timecode.Second + 999*timecode.Millisecond,
+			epoch,
+			"23:59:59.999",
+		},
+		{
+			12 * timecode.Hour,
+			epoch,
+			"2020-03-05T00:00:00.000Z",
+		},
+		{
+			13*timecode.Hour + 2*timecode.Minute + 3*timecode.Second + 456*timecode.Millisecond,
+			epoch,
+			"2020-03-05T01:02:03.456Z",
+		},
+		{
+			-13 * timecode.Hour,
+			epoch,
+			"2020-03-03T23:00:00.000Z",
+		},
+		{
+			timecode.Minute,
+			epoch.In(time.FixedZone("UTC+2", 2*60*60)),
+			"12:01:00.000",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatUTC(test.epoch)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
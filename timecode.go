@@ -1,9 +1,12 @@
 package timecode
 
 import (
+	"flag"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
+	"strings"
 )
 
 // Regex can be used to validate timecodes and capture the sign (optional)
@@ -12,6 +15,11 @@ import (
 // may either be a dot or a comma.
 var Regex = regexp.MustCompile(`([-])?([01]\d|2[0123]):([012345]\d):([012345]\d)(?:[.,](\d{3}))?`)
 
+// RegexWideHours is like Regex, but accepts any number of hours of two or more
+// digits (e.g. "100:00:00.000"), as produced by FormatWithPadding. The capture
+// groups are the same as Regex.
+var RegexWideHours = regexp.MustCompile(`([-])?(\d{2,}):([012345]\d):([012345]\d)(?:[.,](\d{3}))?`)
+
 // Some basic Timecode units
 const (
 	Zero        = Timecode(0)
@@ -21,6 +29,16 @@ const (
 	Hour        = Minute * 60
 )
 
+// The bounds of a valid Timecode on a 24 hour clock, as per IsValid.
+const (
+	MinTimecode = Zero
+	MaxTimecode = 24*Hour - Millisecond
+)
+
+// DefaultFormat is used by String to format Timecodes. It may be replaced at
+// startup to change String output globally, e.g. with Timecode.FormatComma.
+var DefaultFormat func(Timecode) string = Timecode.FormatDot
+
 // Timecode defines a timecode that would typically be used to denote a duration
 // or position in media, e.g. for a subtitle or audio track.
 //
@@ -30,15 +48,14 @@ const (
 // long as the result is cast back to Timecode)
 type Timecode int64
 
-// Check we implement the interface
+// Check we implement the interfaces
 var _ fmt.Stringer = Zero
+var _ fmt.GoStringer = Zero
+var _ flag.Value = (*Timecode)(nil)
 
 // HourMinuteSecondMilli returns the constituent elements of a timecode.
 func (t Timecode) HourMinuteSecondMilli() (uint64, uint64, uint64, uint64) {
-	i := uint64(t)
-	if t.IsNegative() {
-		i = uint64(t * -1)
-	}
+	i := uint64(t.Abs())
 
 	milli := i % 1000
 	i = i / 1000
@@ -51,11 +68,84 @@ func (t Timecode) HourMinuteSecondMilli() (uint64, uint64, uint64, uint64) {
 	return hour, minute, second, milli
 }
 
+// HoursComponent returns the hours element of t, as per HourMinuteSecondMilli.
+func (t Timecode) HoursComponent() uint64 {
+	h, _, _, _ := t.HourMinuteSecondMilli()
+	return h
+}
+
+// MinutesComponent returns the minutes element of t (0-59), as per
+// HourMinuteSecondMilli.
+func (t Timecode) MinutesComponent() uint64 {
+	_, m, _, _ := t.HourMinuteSecondMilli()
+	return m
+}
+
+// SecondsComponent returns the seconds element of t (0-59), as per
+// HourMinuteSecondMilli.
+func (t Timecode) SecondsComponent() uint64 {
+	_, _, s, _ := t.HourMinuteSecondMilli()
+	return s
+}
+
+// MillisComponent returns the milliseconds element of t (0-999), as per
+// HourMinuteSecondMilli.
+func (t Timecode) MillisComponent() uint64 {
+	_, _, _, ms := t.HourMinuteSecondMilli()
+	return ms
+}
+
 // IsNegative is true if Timecode is below zero
 func (t Timecode) IsNegative() bool {
 	return t < Zero
 }
 
+// IsZero is true if Timecode is Zero
+func (t Timecode) IsZero() bool {
+	return t == Zero
+}
+
+// IsValid is true if t falls within a 24 hour clock, i.e. between
+// MinTimecode and MaxTimecode (inclusive). This is the same range that Regex
+// accepts, but Timecodes built with arithmetic or FromParams may fall outside it.
+func (t Timecode) IsValid() bool {
+	return t >= MinTimecode && t <= MaxTimecode
+}
+
+// Validate returns an error if t is not IsValid.
+func (t Timecode) Validate() error {
+	if !t.IsValid() {
+		return fmt.Errorf("[%s] is outside of the valid timecode range [%s, %s]",
+			t.FormatDot(), MinTimecode.FormatDot(), MaxTimecode.FormatDot())
+	}
+	return nil
+}
+
+// Abs returns the absolute value of t.
+func (t Timecode) Abs() Timecode {
+	if t.IsNegative() {
+		return t.Negate()
+	}
+	return t
+}
+
+// Positive returns t as a forward (non-negative) Timecode. It is the same as
+// Abs.
+func (t Timecode) Positive() Timecode {
+	return t.Abs()
+}
+
+// Negate returns t with its sign flipped.
+func (t Timecode) Negate() Timecode {
+	return t * -1
+}
+
+// OverlapsWith is true if t and other are less than tolerance apart, in
+// either direction. E.g. entryStart.OverlapsWith(prevEnd, 100*Millisecond).
+func (t Timecode) OverlapsWith(other Timecode, tolerance Timecode) bool {
+	return AbsDiff(t, other) < tolerance
+}
+
 // WithHours returns a new Timecode with the hours set as given.
 func (t Timecode) WithHours(hour uint64) Timecode {
 	_, m, s, ms := t.HourMinuteSecondMilli()
@@ -80,26 +170,64 @@ func (t Timecode) WithMilli(milli uint64) Timecode {
 	return FromParams(t.IsNegative(), h, m, s, milli)
 }
 
+// WithSign returns a new Timecode with the same magnitude as t, which is
+// negative if negative is true. Zero is unaffected.
+func (t Timecode) WithSign(negative bool) Timecode {
+	if negative {
+		return t.Abs().Negate()
+	}
+	return t.Abs()
+}
+
 // Format formats a Timecode into a string.
 //
 // If withMilli is true, then milliSeperator is used to separate the seconds
 // section from the milliseconds section.
 func (t Timecode) Format(withMilli bool, milliSeperator string) string {
-	negative := t.IsNegative()
+	return string(t.AppendFormat(make([]byte, 0, 16), withMilli, milliSeperator))
+}
+
+// AppendFormat is like Format, but appends the result to b and returns the
+// extended buffer. This avoids an allocation if b has enough capacity.
+func (t Timecode) AppendFormat(b []byte, withMilli bool, milliSeperator string) []byte {
+	return t.appendFormat(b, 2, withMilli, milliSeperator)
+}
+
+// FormatWithPadding is like FormatDot, but pads the hours to at least
+// hoursWidth digits (and never fewer than 2), e.g. "100:00:00.000" or
+// "001:02:03.004" for a hoursWidth of 3.
+func (t Timecode) FormatWithPadding(hoursWidth int) string {
+	if hoursWidth < 2 {
+		hoursWidth = 2
+	}
+	return string(t.appendFormat(make([]byte, 0, 14+hoursWidth), hoursWidth, true, "."))
+}
+
+func (t Timecode) appendFormat(b []byte, hoursWidth int, withMilli bool, milliSeperator string) []byte {
 	h, m, s, ms := t.HourMinuteSecondMilli()
 
-	var result string
+	if t.IsNegative() {
+		b = append(b, '-')
+	}
+	b = appendPadded(b, h, hoursWidth)
+	b = append(b, ':')
+	b = appendPadded(b, m, 2)
+	b = append(b, ':')
+	b = appendPadded(b, s, 2)
 	if withMilli {
-		result = fmt.Sprintf("%02d:%02d:%02d%s%03d",
-			h, m, s, milliSeperator, ms)
-	} else {
-		result = fmt.Sprintf("%02d:%02d:%02d", h, m, s)
+		b = append(b, milliSeperator...)
+		b = appendPadded(b, ms, 3)
 	}
+	return b
+}
 
-	if negative {
-		return fmt.Sprintf("-%s", result)
+// FormatWithSign is like Format, but prefixes positive Timecodes with "+".
+// Negative Timecodes are prefixed with "-" as usual, and Zero has no prefix.
+func (t Timecode) FormatWithSign(withMilli bool, milliSeperator string) string {
+	result := t.Format(withMilli, milliSeperator)
+	if t > Zero {
+		return "+" + result
 	}
-
 	return result
 }
 
@@ -113,13 +241,92 @@ func (t Timecode) FormatComma() string {
 	return t.Format(true, ",")
 }
 
-// String is the same as FormatDot. It should be used for logging; non-business
-// logic purposes as this format is NOT guaranteed.
+// FormatNoHours will format as e.g. 05:32.100 if t is under an hour (in either
+// direction), or as FormatDot otherwise.
+func (t Timecode) FormatNoHours() string {
+	if t.HoursComponent() != 0 {
+		return t.FormatDot()
+	}
+	return t.formatMinSecMilli()
+}
+
+// FormatMinSecMilli will format as e.g. 05:32.100, and returns an error if t
+// is an hour or more (in either direction).
+func (t Timecode) FormatMinSecMilli() (string, error) {
+	if t.HoursComponent() != 0 {
+		return "", fmt.Errorf("[%s] cannot be formatted without hours", t.FormatDot())
+	}
+	return t.formatMinSecMilli(), nil
+}
+
+// String formats using DefaultFormat (FormatDot unless changed). It should be
+// used for logging; non-business logic purposes as this format is NOT
+// guaranteed.
 func (t Timecode) String() string {
-	return t.FormatDot()
+	return DefaultFormat(t)
+}
+
+// GoString formats t as the Go expression which constructs it, e.g.
+// "timecode.FromParams(false, 1, 2, 3, 456)", for use with %#v.
+func (t Timecode) GoString() string {
+	h, m, s, ms := t.HourMinuteSecondMilli()
+	return fmt.Sprintf("timecode.FromParams(%t, %d, %d, %d, %d)", t.IsNegative(), h, m, s, ms)
+}
+
+// Set parses str into t, so that *Timecode may be used as a flag.Value (and
+// pflag.Value). t is left unchanged if str is not a valid timecode.
+func (t *Timecode) Set(str string) error {
+	parsed, err := Parse(str)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// Type returns "timecode", which pflag uses to describe the flag's value.
+func (t *Timecode) Type() string {
+	return "timecode"
+}
+
+// Ratio returns t as a fraction of total, e.g. 0.5 if t is half of total, as
+// for a progress bar. The result is negative if exactly one of t and total is
+// negative, and NaN if total is Zero, since the ratio is undefined.
+func (t Timecode) Ratio(total Timecode) float64 {
+	if total == Zero {
+		return math.NaN()
+	}
+	return float64(t) / float64(total)
+}
+
+// Percentage returns t as a percentage of total, e.g. 50 if t is half of
+// total. An error is returned if total is Zero.
+func (t Timecode) Percentage(total Timecode) (float64, error) {
+	if total == Zero {
+		return 0, fmt.Errorf("cannot compute percentage of a zero total")
+	}
+	return 100.0 * t.Ratio(total), nil
+}
+
+// FormatPercentageString formats the Percentage of t in total with
+// decimalPlaces digits after the decimal point and a "%" suffix, e.g. "50.0%".
+func (t Timecode) FormatPercentageString(total Timecode, decimalPlaces int) (string, error) {
+	if decimalPlaces < 0 {
+		return "", fmt.Errorf("[%d] is not a valid number of decimal places", decimalPlaces)
+	}
+	p, err := t.Percentage(total)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(p, 'f', decimalPlaces, 64) + "%", nil
 }
 
 // FromParams constructs a Timecode from its constituent parts.
+//
+// The parts need not be within their usual ranges, and overflow carries into
+// the next unit, e.g. FromParams(false, 0, 0, 90, 0) is 1m30s. Since a Timecode
+// is just a count of milliseconds, HourMinuteSecondMilli will always give back
+// normalized parts.
 func FromParams(negative bool, hour, minute, second, milli uint64) Timecode {
 	total := Timecode(milli) * Millisecond
 	total += Timecode(second) * Second
@@ -131,6 +338,85 @@ func FromParams(negative bool, hour, minute, second, milli uint64) Timecode {
 	return total
 }
 
+// ParseFromComponents constructs a Timecode from the string forms of its
+// constituent parts, e.g. the columns of a CSV file. Each part must be a
+// non-negative whole number, and the minutes and seconds must be below 60 and
+// the milliseconds below 1000.
+func ParseFromComponents(h, m, s, ms string) (Timecode, error) {
+	var parsed [4]uint64
+	for i, c := range []struct {
+		name  string
+		value string
+		limit uint64
+	}{{"hours", h, 0}, {"minutes", m, 60}, {"seconds", s, 60}, {"milliseconds", ms, 1000}} {
+		value, err := strconv.ParseUint(c.value, 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("%s [%s] is not a valid number", c.name, c.value)
+		}
+		if c.limit != 0 && value >= c.limit {
+			return Zero, fmt.Errorf("%s [%d] must be below %d", c.name, value, c.limit)
+		}
+		parsed[i] = value
+	}
+	return FromParams(false, parsed[0], parsed[1], parsed[2], parsed[3]), nil
+}
+
+// FromMilliseconds converts a count of milliseconds into a Timecode.
+func FromMilliseconds(ms int64) Timecode {
+	return Timecode(ms) * Millisecond
+}
+
+// ToMilliseconds converts t into a count of milliseconds.
+func (t Timecode) ToMilliseconds() int64 {
+	return int64(t / Millisecond)
+}
+
+// FromSeconds converts a (possibly fractional) number of seconds into a
+// Timecode, truncating toward zero to millisecond precision. E.g. 1.5 gives
+// 1500ms. Floating point error is allowed for, so that e.g. 1.001 gives 1001ms
+// rather than 1000ms.
+//
+// NaN gives Zero, and values beyond the range of a Timecode (including
+// infinities) are clamped to the largest or smallest Timecode. Use
+// ParseDecimalSeconds to get an error instead.
+func FromSeconds(s float64) Timecode {
+	milli := s * 1000
+	switch {
+	case math.IsNaN(milli):
+		return Zero
+	case milli >= math.MaxInt64:
+		return Timecode(math.MaxInt64)
+	case milli < math.MinInt64:
+		return Timecode(math.MinInt64)
+	}
+	rounded := math.Round(milli)
+	if math.Abs(milli-rounded) < 1e-6 {
+		return Timecode(rounded)
+	}
+	return Timecode(math.Trunc(milli))
+}
+
+// ParseDecimalSeconds extracts a Timecode from a string holding a (possibly
+// fractional or negative) number of seconds, e.g. "3600.5", rounding to the
+// nearest millisecond. Any format accepted by strconv.ParseFloat may be used,
+// but infinite, NaN, and out of range values return an error.
+func ParseDecimalSeconds(str string) (Timecode, error) {
+	s, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return Zero, fmt.Errorf("[%s] is not a decimal number of seconds", str)
+	}
+	milli := math.Round(s * 1000)
+	if math.IsNaN(milli) || milli >= math.MaxInt64 || milli < math.MinInt64 {
+		return Zero, fmt.Errorf("[%s] is out of range for a timecode", str)
+	}
+	return Timecode(milli), nil
+}
+
+// ToSeconds converts t into a (possibly fractional) number of seconds.
+func (t Timecode) ToSeconds() float64 {
+	return float64(t) / 1000
+}
+
 // Parse extracts a Timecode from a string. The following are valid examples
 // of Timecodes (non-valid Timecodes will return an error):
 // "01:02:03.456"
@@ -138,19 +424,114 @@ func FromParams(negative bool, hour, minute, second, milli uint64) Timecode {
 // "-01:02:03.456"
 // "01:02:03"
 // "crouching.tiger.01:02:03.456.hidden.timecode"
+//
+// The error is a *ParseError.
 func Parse(str string) (Timecode, error) {
 	m := Regex.FindStringSubmatch(str)
 	if len(m) == 0 {
-		return Zero, fmt.Errorf("[%s] is not a timecode", str)
+		return Zero, newParseError(str, defaultGrammar)
+	}
+
+	return fromRegexMatch(m), nil
+}
+
+// MustParse is like Parse, but panics if str is not a valid timecode. It
+// simplifies the initialization of variables and test fixtures.
+func MustParse(str string) Timecode {
+	t, err := Parse(str)
+	if err != nil {
+		panic(fmt.Sprintf("timecode: MustParse(%q): %v", str, err))
+	}
+	return t
+}
+
+// ParseWithFallback is like Parse, but returns fallback if str is not a valid
+// timecode, e.g. so that one malformed subtitle cue can take the previous cue's
+// end rather than aborting the whole file.
+func ParseWithFallback(str string, fallback Timecode) Timecode {
+	t, err := Parse(str)
+	if err != nil {
+		return fallback
+	}
+	return t
+}
+
+// ParseAnnotated extracts a Timecode along with the label which precedes it,
+// e.g. "intro: 00:01:23.456" gives "intro" and 00:01:23.456. Whitespace and a
+// trailing colon are trimmed from the label, which is empty if nothing
+// precedes the timecode. The error is a *ParseError, as with Parse.
+func ParseAnnotated(str string) (label string, t Timecode, err error) {
+	loc := Regex.FindStringSubmatchIndex(str)
+	if loc == nil {
+		return "", Zero, newParseError(str, defaultGrammar)
 	}
 
-	negative := isNotEmpty(m, 1)
-	hour := parseNumber(m, 2)
-	minute := parseNumber(m, 3)
-	second := parseNumber(m, 4)
-	milli := parseNumber(m, 5)
+	label = strings.TrimSpace(str[:loc[0]])
+	label = strings.TrimSpace(strings.TrimSuffix(label, ":"))
+	return label, fromRegexMatch(submatches(str, loc)), nil
+}
+
+// ParseAll extracts every Timecode found in a string, in the order they
+// appear, e.g. "00:00:01.000 --> 00:00:02.000" gives two Timecodes. If there
+// are no timecodes in the string, the result is empty.
+func ParseAll(str string) []Timecode {
+	matches := Regex.FindAllStringSubmatch(str, -1)
+	result := make([]Timecode, len(matches))
+	for i, m := range matches {
+		result[i] = fromRegexMatch(m)
+	}
+	return result
+}
 
-	return FromParams(negative, hour, minute, second, milli), nil
+// ParseMany parses each of strs with Parse. The results are parallel to strs:
+// if strs[i] is valid then timecodes[i] holds its Timecode and errs[i] is nil,
+// otherwise timecodes[i] is Zero and errs[i] holds the error.
+func ParseMany(strs []string) (timecodes []Timecode, errs []error) {
+	timecodes = make([]Timecode, len(strs))
+	errs = make([]error, len(strs))
+	for i, str := range strs {
+		timecodes[i], errs[i] = Parse(str)
+	}
+	return timecodes, errs
+}
+
+// ParseManyStrict parses each of strs with Parse, stopping at the first error.
+// The error identifies the index of the invalid string.
+func ParseManyStrict(strs []string) ([]Timecode, error) {
+	timecodes := make([]Timecode, len(strs))
+	for i, str := range strs {
+		t, err := Parse(str)
+		if err != nil {
+			return nil, fmt.Errorf("index [%d]: %w", i, err)
+		}
+		timecodes[i] = t
+	}
+	return timecodes, nil
+}
+
+func fromRegexMatch(regexMatch []string) Timecode {
+	negative := isNotEmpty(regexMatch, 1)
+	hour := parseNumber(regexMatch, 2)
+	minute := parseNumber(regexMatch, 3)
+	second := parseNumber(regexMatch, 4)
+	milli := parseNumber(regexMatch, 5)
+
+	return FromParams(negative, hour, minute, second, milli)
+}
+
+func (t Timecode) formatMinSecMilli() string {
+	_, m, s, ms := t.HourMinuteSecondMilli()
+
+	b := make([]byte, 0, 10)
+	if t.IsNegative() {
+		b = append(b, '-')
+	}
+	b = appendPadded(b, m, 2)
+	b = append(b, ':')
+	b = appendPadded(b, s, 2)
+	b = append(b, '.')
+	b = appendPadded(b, ms, 3)
+	return string(b)
 }
 
 func parseNumber(regexMatch []string, i int) uint64 {
@@ -159,6 +540,17 @@ func parseNumber(regexMatch []string, i int) uint64 {
 	return result
 }
 
+func appendPadded(b []byte, value uint64, width int) []byte {
+	digits := 1
+	for v := value; v >= 10; v /= 10 {
+		digits++
+	}
+	for ; digits < width; digits++ {
+		b = append(b, '0')
+	}
+	return strconv.AppendUint(b, value, 10)
+}
+
 func isNotEmpty(regexMatch []string, i int) bool {
 	value := regexMatch[i]
 	return value != ""
@@ -2,6 +2,7 @@ package timecode_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/liampulles/go-timecode"
@@ -62,6 +63,113 @@ func TestTimecode_Format_NoMillisecondCase(t *testing.T) {
 	assert.Equal(t, "01:02:03", actual)
 }
 
+func TestTimecode_Format_LargeHours(t *testing.T) {
+	// Setup fixture
+	sut := 123*timecode.Hour + timecode.Millisecond
+
+	// Exercise SUT
+	actual := sut.Format(true, ".")
+
+	// Verify result
+	assert.Equal(t, "123:00:00.001", actual)
+}
+
+func TestTimecode_FormatWithPadding(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode   timecode.Timecode
+		hoursWidth int
+		expected   string
+	}{
+		{timecode.Zero, 2, "00:00:00.000"},
+		{timecode.Zero, 0, "00:00:00.000"},
+		{timecode.Zero, -1, "00:00:00.000"},
+		{timecode.Timecode(3723004), 3, "001:02:03.004"},
+		{100 * timecode.Hour, 3, "100:00:00.000"},
+		{100 * timecode.Hour, 2, "100:00:00.000"},
+		{-100 * timecode.Hour, 4, "-0100:00:00.000"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatWithPadding(test.hoursWidth)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_AppendFormat(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode  timecode.Timecode
+		withMilli bool
+		sep       string
+		expected  string
+	}{
+		{timecode.Zero, true, ".", "prefix 00:00:00.000"},
+		{timecode.Zero, false, ".", "prefix 00:00:00"},
+		{timecode.Timecode(3723456), true, ",", "prefix 01:02:03,456"},
+		{timecode.Timecode(-3723456), true, ";", "prefix -01:02:03;456"},
+		{timecode.Timecode(-3723456), false, ";", "prefix -01:02:03"},
+		{timecode.Timecode(36003007), true, ".", "prefix 10:00:03.007"},
+		{100 * timecode.Hour, true, ".", "prefix 100:00:00.000"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.AppendFormat([]byte("prefix "), test.withMilli, test.sep)
+
+			// Verify result
+			assert.Equal(t, test.expected, string(actual))
+		})
+	}
+}
+
+func TestTimecode_AppendFormat_NoAllocations(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Timecode(-3723456)
+	buf := make([]byte, 0, 32)
+
+	// Exercise SUT
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = sut.AppendFormat(buf[:0], true, ".")
+	})
+
+	// Verify result
+	assert.Equal(t, 0.0, allocs)
+	assert.Equal(t, "-01:02:03.456", string(buf))
+}
+
+func TestTimecode_FormatWithSign(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode  timecode.Timecode
+		withMilli bool
+		expected  string
+	}{
+		{timecode.Zero, true, "00:00:00.000"},
+		{timecode.Zero, false, "00:00:00"},
+		{timecode.Timecode(3723456), true, "+01:02:03.456"},
+		{timecode.Timecode(3723456), false, "+01:02:03"},
+		{timecode.Timecode(-3723456), true, "-01:02:03.456"},
+		{timecode.Millisecond, true, "+00:00:00.001"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatWithSign(test.withMilli, ".")
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
 func TestTimecode_FormatComma(t *testing.T) {
 	// Setup fixture
 	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
@@ -73,6 +181,65 @@ func TestTimecode_FormatComma(t *testing.T) {
 	assert.Equal(t, "01:02:03,004", actual)
 }
 
+func TestTimecode_FormatNoHours(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "00:00.000"},
+		{5*timecode.Minute + 32*timecode.Second + 100*timecode.Millisecond, "05:32.100"},
+		{-(5*timecode.Minute + 32*timecode.Second + 100*timecode.Millisecond), "-05:32.100"},
+		{timecode.Hour - timecode.Millisecond, "59:59.999"},
+		{timecode.Hour, "01:00:00.000"},
+		{-timecode.Hour, "-01:00:00.000"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.FormatNoHours()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatMinSecMilli_ValidCase(t *testing.T) {
+	// Setup fixture
+	sut := 5*timecode.Minute + 32*timecode.Second + 100*timecode.Millisecond
+
+	// Exercise SUT
+	actual, err := sut.FormatMinSecMilli()
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, "05:32.100", actual)
+}
+
+func TestTimecode_FormatMinSecMilli_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Hour, "[01:00:00.000] cannot be formatted without hours"},
+		{-timecode.Hour, "[-01:00:00.000] cannot be formatted without hours"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := test.timecode.FormatMinSecMilli()
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, "", actual)
+		})
+	}
+}
+
 func TestTimecode_String(t *testing.T) {
 	// Setup fixture
 	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
@@ -84,104 +251,717 @@ func TestTimecode_String(t *testing.T) {
 	assert.Equal(t, "01:02:03.004", actual)
 }
 
+func TestTimecode_String_WithDefaultFormat(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+	original := timecode.DefaultFormat
+	defer func() { timecode.DefaultFormat = original }()
+	timecode.DefaultFormat = timecode.Timecode.FormatComma
+
+	// Exercise SUT
+	actual := sut.String()
+
+	// Verify result
+	assert.Equal(t, "01:02:03,004", actual)
+}
+
+func TestTimecode_GoString(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected string
+	}{
+		{timecode.Zero, "timecode.FromParams(false, 0, 0, 0, 0)"},
+		{timecode.Timecode(3723456), "timecode.FromParams(false, 1, 2, 3, 456)"},
+		{timecode.Timecode(-3723456), "timecode.FromParams(true, 1, 2, 3, 456)"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := fmt.Sprintf("%#v", test.timecode)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Set_ValidCase(t *testing.T) {
+	// Setup fixture
+	var sut timecode.Timecode
+
+	// Exercise SUT
+	err := sut.Set("01:02:03.456")
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, timecode.Timecode(3723456), sut)
+}
+
+func TestTimecode_Set_InvalidCase(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Second
+
+	// Exercise SUT
+	err := sut.Set("not.a.timecode")
+
+	// Verify result
+	assert.Error(t, err)
+	assert.Equal(t, timecode.Second, sut)
+}
+
+func TestTimecode_Type(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Zero
+
+	// Exercise SUT
+	actual := sut.Type()
+
+	// Verify result
+	assert.Equal(t, "timecode", actual)
+}
+
+func TestTimecode_Components(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		hour     uint64
+		minute   uint64
+		second   uint64
+		milli    uint64
+	}{
+		{timecode.Zero, 0, 0, 0, 0},
+		{timecode.Timecode(3723456), 1, 2, 3, 456},
+		{timecode.Timecode(-3723456), 1, 2, 3, 456},
+		{123*timecode.Hour + 59*timecode.Minute + 59*timecode.Second + 999*timecode.Millisecond, 123, 59, 59, 999},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT and verify result
+			assert.Equal(t, test.hour, test.timecode.HoursComponent())
+			assert.Equal(t, test.minute, test.timecode.MinutesComponent())
+			assert.Equal(t, test.second, test.timecode.SecondsComponent())
+			assert.Equal(t, test.milli, test.timecode.MillisComponent())
+		})
+	}
+}
+
+func TestTimecode_IsZero(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected bool
+	}{
+		{timecode.Zero, true},
+		{timecode.Millisecond, false},
+		{-timecode.Millisecond, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.IsZero()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_IsValid(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected bool
+	}{
+		{timecode.MinTimecode, true},
+		{timecode.MaxTimecode, true},
+		{timecode.Timecode(3723456), true},
+		{-timecode.Millisecond, false},
+		{timecode.MaxTimecode + timecode.Millisecond, false},
+		{timecode.FromParams(false, 0, 0, 86400, 0), false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.IsValid()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			if actual {
+				assert.NoError(t, test.timecode.Validate())
+			} else {
+				assert.Error(t, test.timecode.Validate())
+			}
+		})
+	}
+}
+
+func TestTimecode_Validate_InvalidCase(t *testing.T) {
+	// Setup fixture
+	sut := 25 * timecode.Hour
+
+	// Exercise SUT
+	err := sut.Validate()
+
+	// Verify result
+	assert.EqualError(t, err, "[25:00:00.000] is outside of the valid timecode range [00:00:00.000, 23:59:59.999]")
+}
+
+func TestTimecode_Abs(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero},
+		{timecode.Millisecond, timecode.Millisecond},
+		{-timecode.Millisecond, timecode.Millisecond},
+		{timecode.Timecode(3723456), timecode.Timecode(3723456)},
+		{timecode.Timecode(-3723456), timecode.Timecode(3723456)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Abs()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Positive(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero},
+		{timecode.Timecode(3723456), timecode.Timecode(3723456)},
+		{timecode.Timecode(-3723456), timecode.Timecode(3723456)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Positive()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Negate(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, timecode.Zero},
+		{timecode.Millisecond, -timecode.Millisecond},
+		{-timecode.Millisecond, timecode.Millisecond},
+		{timecode.Timecode(3723456), timecode.Timecode(-3723456)},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Negate()
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_OverlapsWith(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode  timecode.Timecode
+		other     timecode.Timecode
+		tolerance timecode.Timecode
+		expected  bool
+	}{
+		{timecode.Zero, timecode.Zero, timecode.Millisecond, true},
+		{timecode.Zero, timecode.Zero, timecode.Zero, false},
+		{timecode.Second, timecode.Second + 99*timecode.Millisecond, 100 * timecode.Millisecond, true},
+		{timecode.Second, timecode.Second + 100*timecode.Millisecond, 100 * timecode.Millisecond, false},
+		{timecode.Second + 99*timecode.Millisecond, timecode.Second, 100 * timecode.Millisecond, true},
+		{-50 * timecode.Millisecond, 40 * timecode.Millisecond, 100 * timecode.Millisecond, true},
+		{timecode.Second, timecode.Second, -timecode.Second, false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.OverlapsWith(test.other, test.tolerance)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
 func TestTimecode_WithHours(t *testing.T) {
 	// Setup fixture
 	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
 
-	// Exercise SUT
-	actual := sut.WithHours(9)
+	// Exercise SUT
+	actual := sut.WithHours(9)
+
+	// Verify result
+	assert.Equal(t, "09:02:03.004", actual.FormatDot())
+}
+
+func TestTimecode_WithMinutes(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+
+	// Exercise SUT
+	actual := sut.WithMinutes(9)
+
+	// Verify result
+	assert.Equal(t, "01:09:03.004", actual.FormatDot())
+}
+
+func TestTimecode_WithSeconds(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+
+	// Exercise SUT
+	actual := sut.WithSeconds(9)
+
+	// Verify result
+	assert.Equal(t, "01:02:09.004", actual.FormatDot())
+}
+
+func TestTimecode_WithMilli(t *testing.T) {
+	// Setup fixture
+	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+
+	// Exercise SUT
+	actual := sut.WithMilli(9)
+
+	// Verify result
+	assert.Equal(t, "01:02:03.009", actual.FormatDot())
+}
+
+func TestTimecode_WithSign(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		negative bool
+		expected timecode.Timecode
+	}{
+		{timecode.Zero, true, timecode.Zero},
+		{timecode.Zero, false, timecode.Zero},
+		{timecode.Second, true, -timecode.Second},
+		{timecode.Second, false, timecode.Second},
+		{-timecode.Second, true, -timecode.Second},
+		{-timecode.Second, false, timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.WithSign(test.negative)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Ratio(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		total    timecode.Timecode
+		expected float64
+	}{
+		{timecode.Zero, timecode.Second, 0.0},
+		{timecode.Second, timecode.Second, 1.0},
+		{500 * timecode.Millisecond, timecode.Second, 0.5},
+		{timecode.Minute, timecode.Second, 60.0},
+		{-timecode.Second, 4 * timecode.Second, -0.25},
+		{timecode.Second, -4 * timecode.Second, -0.25},
+		{-timecode.Second, -4 * timecode.Second, 0.25},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := test.timecode.Ratio(test.total)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Ratio_ZeroTotal(t *testing.T) {
+	// Exercise SUT
+	actual := timecode.Second.Ratio(timecode.Zero)
+
+	// Verify result
+	assert.True(t, math.IsNaN(actual))
+}
+
+func TestTimecode_Percentage_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode timecode.Timecode
+		total    timecode.Timecode
+		expected float64
+	}{
+		{timecode.Zero, timecode.Second, 0.0},
+		{timecode.Second, timecode.Second, 100.0},
+		{500 * timecode.Millisecond, timecode.Second, 50.0},
+		{timecode.Minute, timecode.Second, 6000.0},
+		{-timecode.Second, 4 * timecode.Second, -25.0},
+		{timecode.Second, -4 * timecode.Second, -25.0},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := test.timecode.Percentage(test.total)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_Percentage_ZeroTotal(t *testing.T) {
+	// Exercise SUT
+	_, err := timecode.Second.Percentage(timecode.Zero)
+
+	// Verify result
+	assert.Error(t, err)
+}
+
+func TestTimecode_FormatPercentageString_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		timecode      timecode.Timecode
+		total         timecode.Timecode
+		decimalPlaces int
+		expected      string
+	}{
+		{timecode.Zero, timecode.Second, 0, "0%"},
+		{500 * timecode.Millisecond, timecode.Second, 1, "50.0%"},
+		{timecode.Second, 3 * timecode.Second, 2, "33.33%"},
+		{2 * timecode.Second, 3 * timecode.Second, 0, "67%"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := test.timecode.FormatPercentageString(test.total, test.decimalPlaces)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestTimecode_FormatPercentageString_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		total         timecode.Timecode
+		decimalPlaces int
+	}{
+		{timecode.Zero, 1},
+		{timecode.Second, -1},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.Second.FormatPercentageString(test.total, test.decimalPlaces)
+
+			// Verify result
+			assert.Error(t, err)
+			assert.Equal(t, "", actual)
+		})
+	}
+}
+
+func TestFromParams(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		negative    bool
+		hour        uint64
+		minute      uint64
+		second      uint64
+		Millisecond uint64
+		expected    timecode.Timecode
+	}{
+		{
+			false,
+			0, 0, 0, 0,
+			timecode.Zero,
+		},
+		{
+			false,
+			0, 0, 0, 1,
+			timecode.Millisecond,
+		},
+		{
+			false,
+			0, 0, 1, 0,
+			timecode.Second,
+		},
+		{
+			false,
+			0, 1, 0, 0,
+			timecode.Minute,
+		},
+		{
+			false,
+			1, 0, 0, 0,
+			timecode.Hour,
+		},
+		{
+			false,
+			1, 2, 3, 456,
+			timecode.Timecode(3723456),
+		},
+		{
+			true,
+			1, 2, 3, 456,
+			timecode.Timecode(-3723456),
+		},
+		{
+			false,
+			0, 0, 90, 0,
+			timecode.Minute + 30*timecode.Second,
+		},
+		{
+			false,
+			0, 62, 63, 1004,
+			timecode.Timecode(3784004),
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.FromParams(test.negative, test.hour, test.minute, test.second, test.Millisecond)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFromParams_NormalizesOverflow(t *testing.T) {
+	// Exercise SUT
+	h, m, s, ms := timecode.FromParams(false, 25, 61, 61, 1001).HourMinuteSecondMilli()
+
+	// Verify result
+	assert.Equal(t, []uint64{26, 2, 2, 1}, []uint64{h, m, s, ms})
+}
+
+func TestParseFromComponents_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		h, m, s, ms string
+		expected    timecode.Timecode
+	}{
+		{"0", "0", "0", "0", timecode.Zero},
+		{"01", "02", "03", "456", timecode.Timecode(3723456)},
+		{"1", "2", "3", "4", timecode.Timecode(3723004)},
+		{"100", "59", "59", "999", 100*timecode.Hour + 59*timecode.Minute + 59*timecode.Second + 999*timecode.Millisecond},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFromComponents(test.h, test.m, test.s, test.ms)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseFromComponents_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		h, m, s, ms string
+		expected    string
+	}{
+		{"", "0", "0", "0", "hours [] is not a valid number"},
+		{"-1", "0", "0", "0", "hours [-1] is not a valid number"},
+		{"0", "x", "0", "0", "minutes [x] is not a valid number"},
+		{"0", "0", " 1", "0", "seconds [ 1] is not a valid number"},
+		{"0", "0", "0", "1.5", "milliseconds [1.5] is not a valid number"},
+		{"99999999999", "0", "0", "0", "hours [99999999999] is not a valid number"},
+		{"0", "60", "0", "0", "minutes [60] must be below 60"},
+		{"0", "0", "60", "0", "seconds [60] must be below 60"},
+		{"0", "0", "0", "1000", "milliseconds [1000] must be below 1000"},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseFromComponents(test.h, test.m, test.s, test.ms)
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
+}
+
+func TestMilliseconds(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		ms       int64
+		expected timecode.Timecode
+	}{
+		{0, timecode.Zero},
+		{1, timecode.Millisecond},
+		{-1, -timecode.Millisecond},
+		{3723456, timecode.Timecode(3723456)},
+		{-3723456, timecode.Timecode(-3723456)},
+	}
 
-	// Verify result
-	assert.Equal(t, "09:02:03.004", actual.FormatDot())
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.FromMilliseconds(test.ms)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.ms, actual.ToMilliseconds())
+		})
+	}
 }
 
-func TestTimecode_WithMinutes(t *testing.T) {
-	// Setup fixture
-	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+func TestFromSeconds(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		seconds  float64
+		expected timecode.Timecode
+	}{
+		{0, timecode.Zero},
+		{1, timecode.Second},
+		{1.5, 1500 * timecode.Millisecond},
+		{-1.5, -1500 * timecode.Millisecond},
+		{1.001, 1001 * timecode.Millisecond},
+		{0.29, 290 * timecode.Millisecond},
+		{4.35, 4350 * timecode.Millisecond},
+		{0.0019, timecode.Millisecond},
+		{-0.0019, -timecode.Millisecond},
+		{0.0009, timecode.Zero},
+		{3723.456, timecode.Timecode(3723456)},
+		{math.NaN(), timecode.Zero},
+		{math.Inf(1), timecode.Timecode(math.MaxInt64)},
+		{math.Inf(-1), timecode.Timecode(math.MinInt64)},
+		{1e30, timecode.Timecode(math.MaxInt64)},
+		{-1e30, timecode.Timecode(math.MinInt64)},
+	}
 
-	// Exercise SUT
-	actual := sut.WithMinutes(9)
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.FromSeconds(test.seconds)
 
-	// Verify result
-	assert.Equal(t, "01:09:03.004", actual.FormatDot())
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
 }
 
-func TestTimecode_WithSeconds(t *testing.T) {
-	// Setup fixture
-	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+func TestParseDecimalSeconds_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"0", timecode.Zero},
+		{"3600.5", timecode.Hour + 500*timecode.Millisecond},
+		{"-3600.5", -(timecode.Hour + 500*timecode.Millisecond)},
+		{"1.001", timecode.Timecode(1001)},
+		{"0.0004", timecode.Zero},
+		{"0.0005", timecode.Millisecond},
+		{"-0.0005", -timecode.Millisecond},
+		{"1e3", 1000 * timecode.Second},
+		{"+.25", 250 * timecode.Millisecond},
+	}
 
-	// Exercise SUT
-	actual := sut.WithSeconds(9)
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseDecimalSeconds(test.str)
 
-	// Verify result
-	assert.Equal(t, "01:02:09.004", actual.FormatDot())
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
 }
 
-func TestTimecode_WithMilli(t *testing.T) {
-	// Setup fixture
-	sut := timecode.Hour + (2 * timecode.Minute) + (3 * timecode.Second) + (4 * timecode.Millisecond)
+func TestParseDecimalSeconds_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected string
+	}{
+		{"", "[] is not a decimal number of seconds"},
+		{"1,5", "[1,5] is not a decimal number of seconds"},
+		{"00:00:01", "[00:00:01] is not a decimal number of seconds"},
+		{"1s", "[1s] is not a decimal number of seconds"},
+		{"NaN", "[NaN] is out of range for a timecode"},
+		{"-Inf", "[-Inf] is out of range for a timecode"},
+		{"1e17", "[1e17] is out of range for a timecode"},
+	}
 
-	// Exercise SUT
-	actual := sut.WithMilli(9)
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := timecode.ParseDecimalSeconds(test.str)
 
-	// Verify result
-	assert.Equal(t, "01:02:03.009", actual.FormatDot())
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Equal(t, timecode.Zero, actual)
+		})
+	}
 }
 
-func TestFromParams(t *testing.T) {
+func TestTimecode_ToSeconds(t *testing.T) {
 	// Setup expectations
 	var tests = []struct {
-		negative    bool
-		hour        uint64
-		minute      uint64
-		second      uint64
-		Millisecond uint64
-		expected    timecode.Timecode
+		timecode timecode.Timecode
+		expected float64
 	}{
-		{
-			false,
-			0, 0, 0, 0,
-			timecode.Zero,
-		},
-		{
-			false,
-			0, 0, 0, 1,
-			timecode.Millisecond,
-		},
-		{
-			false,
-			0, 0, 1, 0,
-			timecode.Second,
-		},
-		{
-			false,
-			0, 1, 0, 0,
-			timecode.Minute,
-		},
-		{
-			false,
-			1, 0, 0, 0,
-			timecode.Hour,
-		},
-		{
-			false,
-			1, 2, 3, 456,
-			timecode.Timecode(3723456),
-		},
-		{
-			true,
-			1, 2, 3, 456,
-			timecode.Timecode(-3723456),
-		},
+		{timecode.Zero, 0},
+		{timecode.Millisecond, 0.001},
+		{1500 * timecode.Millisecond, 1.5},
+		{-1500 * timecode.Millisecond, -1.5},
+		{timecode.Timecode(3723456), 3723.456},
 	}
 
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
 			// Exercise SUT
-			actual := timecode.FromParams(test.negative, test.hour, test.minute, test.second, test.Millisecond)
+			actual := test.timecode.ToSeconds()
 
 			// Verify result
 			assert.Equal(t, test.expected, actual)
+			assert.Equal(t, test.timecode, timecode.FromSeconds(actual))
 		})
 	}
 }
@@ -276,3 +1056,234 @@ func TestParse_InvalidCases(t *testing.T) {
 		})
 	}
 }
+
+func TestRegex(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected bool
+	}{
+		{"00:00:00.000", true},
+		{"00:00:00,001", true},
+		{"-01:02:03.456", true},
+		{"23:59:59.999", true},
+		{"00:00:01", true},
+		{"00:00:01.zzz", true},
+		{"crouching.tiger.00:00:00.001.hidden.timecode", true},
+		{"not.a.timecode", false},
+		{"00:00:0d", false},
+		{"00:0d:00", false},
+		{"0d:00:00", false},
+		{"00:00", false},
+		{"00:00:-00", false},
+		{"24:00:00", false},
+		{"00:60:00", false},
+		{"00:00:60", false},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.Regex.MatchString(test.str)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRegex_CaptureGroups(t *testing.T) {
+	// Exercise SUT
+	actual := timecode.Regex.FindStringSubmatch("-01:02:03.456")
+
+	// Verify result
+	assert.Equal(t, 5, timecode.Regex.NumSubexp())
+	assert.Equal(t, []string{"-01:02:03.456", "-", "01", "02", "03", "456"}, actual)
+}
+
+func TestRegexWideHours(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected []string
+	}{
+		{"00:00:00.000", []string{"00:00:00.000", "", "00", "00", "00", "000"}},
+		{"-100:02:03.456", []string{"-100:02:03.456", "-", "100", "02", "03", "456"}},
+		{"0123:00:00", []string{"0123:00:00", "", "0123", "00", "00", ""}},
+		{"0:00:00", nil},
+		{"100:60:00", nil},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.RegexWideHours.FindStringSubmatch(test.str)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestMustParse_ValidCase(t *testing.T) {
+	// Exercise SUT
+	actual := timecode.MustParse("-01:02:03.456")
+
+	// Verify result
+	assert.Equal(t, timecode.Timecode(-3723456), actual)
+}
+
+func TestMustParse_InvalidCase(t *testing.T) {
+	// Exercise SUT & verify result
+	assert.PanicsWithValue(t,
+		`timecode: MustParse("not.a.timecode"): [not.a.timecode] is not a timecode`,
+		func() { timecode.MustParse("not.a.timecode") })
+}
+
+func TestParseWithFallback(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected timecode.Timecode
+	}{
+		{"-01:02:03.456", timecode.Timecode(-3723456)},
+		{"00:00:00", timecode.Zero},
+		{"not.a.timecode", timecode.Minute},
+		{"", timecode.Minute},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.ParseWithFallback(test.str, timecode.Minute)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseAnnotated_ValidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str           string
+		expectedLabel string
+		expected      timecode.Timecode
+	}{
+		{"intro: 00:01:23.456", "intro", timecode.Timecode(83456)},
+		{"  Chapter 2 -  01:00:00 ", "Chapter 2 -", timecode.Hour},
+		{"credits:-00:00:01", "credits", -timecode.Second},
+		{"cue-7 00:00:01,000 --> 00:00:02,000", "cue-7", timecode.Second},
+		{"00:00:01.000", "", timecode.Second},
+		{" : 00:00:01.000", "", timecode.Second},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actualLabel, actual, err := timecode.ParseAnnotated(test.str)
+
+			// Verify result
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedLabel, actualLabel)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseAnnotated_InvalidCase(t *testing.T) {
+	// Exercise SUT
+	actualLabel, actual, err := timecode.ParseAnnotated("intro: 00:0x:00")
+
+	// Verify result
+	assert.EqualError(t, err, "[intro: 00:0x:00] is not a timecode")
+	assert.Equal(t, "", actualLabel)
+	assert.Equal(t, timecode.Zero, actual)
+}
+
+func TestParseAll(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		str      string
+		expected []timecode.Timecode
+	}{
+		{
+			"",
+			[]timecode.Timecode{},
+		},
+		{
+			"not.a.timecode",
+			[]timecode.Timecode{},
+		},
+		{
+			"01:02:03.456",
+			[]timecode.Timecode{timecode.Timecode(3723456)},
+		},
+		{
+			"00:00:01,000 --> 00:00:02,500",
+			[]timecode.Timecode{timecode.Second, 2*timecode.Second + 500*timecode.Millisecond},
+		},
+		{
+			"cue -01:02:03.456 then 00:01:00 and 00:00:00.001.",
+			[]timecode.Timecode{timecode.Timecode(-3723456), timecode.Minute, timecode.Millisecond},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual := timecode.ParseAll(test.str)
+
+			// Verify result
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseMany(t *testing.T) {
+	// Setup fixture
+	fixture := []string{"01:02:03.456", "not.a.timecode", "-00:00:01"}
+
+	// Exercise SUT
+	actual, errs := timecode.ParseMany(fixture)
+
+	// Verify result
+	assert.Equal(t, []timecode.Timecode{timecode.Timecode(3723456), timecode.Zero, -timecode.Second}, actual)
+	assert.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.Error(t, errs[1])
+	assert.NoError(t, errs[2])
+}
+
+func TestParseMany_Empty(t *testing.T) {
+	// Exercise SUT
+	actual, errs := timecode.ParseMany(nil)
+
+	// Verify result
+	assert.Empty(t, actual)
+	assert.Empty(t, errs)
+}
+
+func TestParseManyStrict_ValidCase(t *testing.T) {
+	// Setup fixture
+	fixture := []string{"01:02:03.456", "-00:00:01"}
+
+	// Exercise SUT
+	actual, err := timecode.ParseManyStrict(fixture)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, []timecode.Timecode{timecode.Timecode(3723456), -timecode.Second}, actual)
+}
+
+func TestParseManyStrict_InvalidCase(t *testing.T) {
+	// Setup fixture
+	fixture := []string{"01:02:03.456", "not.a.timecode", "also.not"}
+
+	// Exercise SUT
+	actual, err := timecode.ParseManyStrict(fixture)
+
+	// Verify result
+	assert.EqualError(t, err, "index [1]: [not.a.timecode] is not a timecode")
+	assert.Nil(t, actual)
+}
@@ -0,0 +1,193 @@
+// Package vtt provides parsing and formatting of WebVTT (.vtt) subtitle files,
+// the HTML5 standard subtitle format.
+package vtt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/liampulles/go-timecode"
+)
+
+// timestamp matches a WebVTT timestamp, where the hours are optional and the
+// milliseconds are required and must be separated by a dot.
+const timestamp = `(?:(\d{2,}):)?([012345]\d):([012345]\d)\.(\d{3})`
+
+// timingLineRegex matches a WebVTT cue timing line, capturing the hours
+// (optional), minutes, seconds, and milliseconds of the start and end, followed
+// by any cue settings.
+var timingLineRegex = regexp.MustCompile(
+	`^` + timestamp + `[ \t]+-->[ \t]+` + timestamp + `(?:[ \t]+(.*?))?[ \t]*$`)
+
+// VTTCue is a single cue in a WebVTT file.
+type VTTCue struct {
+	// ID is the optional cue identifier, or empty if there is none.
+	ID string
+	// Range is when the cue is shown.
+	Range timecode.Range
+	// Settings holds the raw cue settings which follow the timing, e.g.
+	// "position:10% align:start", or empty if there are none.
+	Settings string
+	// Lines holds the cue text, one element per line.
+	Lines []string
+}
+
+// ParseVTTFile reads a complete WebVTT file from r. The file must start with a
+// "WEBVTT" header. NOTE, STYLE, and REGION blocks are skipped, and a leading
+// byte order mark and carriage returns are ignored.
+//
+// An error identifying the offending line is returned if the header or any
+// cue is malformed.
+func ParseVTTFile(r io.Reader) ([]VTTCue, error) {
+	blocks, err := readBlocks(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) == 0 || !isHeader(blocks[0].lines[0]) {
+		return nil, fmt.Errorf("line 1: missing WEBVTT header")
+	}
+
+	cues := []VTTCue{}
+	for _, b := range blocks[1:] {
+		if isIgnoredBlock(b.lines[0]) {
+			continue
+		}
+		cue, err := parseCue(b)
+		if err != nil {
+			return nil, err
+		}
+		cues = append(cues, cue)
+	}
+	return cues, nil
+}
+
+// FormatVTTFile writes cues to w as a WebVTT file, e.g.
+//
+//	WEBVTT
+//
+//	intro
+//	00:00:01.000 --> 00:00:02.500 align:start
+//	Hello there!
+//
+// An error is returned if either end of a cue's Range is negative, since
+// WebVTT timestamps cannot be.
+func FormatVTTFile(cues []VTTCue, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("WEBVTT\n")
+	for i, cue := range cues {
+		if cue.Range.Start.IsNegative() || cue.Range.End.IsNegative() {
+			return fmt.Errorf("cue [%d]: [%s] has a negative timestamp", i, cue.Range)
+		}
+
+		bw.WriteString("\n")
+		if cue.ID != "" {
+			bw.WriteString(cue.ID)
+			bw.WriteString("\n")
+		}
+		bw.WriteString(cue.Range.Format(" --> ", "."))
+		if cue.Settings != "" {
+			bw.WriteString(" ")
+			bw.WriteString(cue.Settings)
+		}
+		bw.WriteString("\n")
+		for _, line := range cue.Lines {
+			bw.WriteString(line)
+			bw.WriteString("\n")
+		}
+	}
+	return bw.Flush()
+}
+
+type block struct {
+	firstLine int
+	lines     []string
+}
+
+func readBlocks(r io.Reader) ([]block, error) {
+	scanner := bufio.NewScanner(r)
+	var blocks []block
+	var current *block
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			blocks = append(blocks, block{firstLine: lineNumber})
+			current = &blocks[len(blocks)-1]
+		}
+		current.lines = append(current.lines, line)
+	}
+	return blocks, scanner.Err()
+}
+
+func parseCue(b block) (VTTCue, error) {
+	var cue VTTCue
+	timingIndex := 0
+	if !strings.Contains(b.lines[0], "-->") {
+		cue.ID = b.lines[0]
+		timingIndex = 1
+	}
+	lineNumber := b.firstLine + timingIndex
+	if timingIndex >= len(b.lines) {
+		return VTTCue{}, fmt.Errorf("line %d: cue [%s] has no timing line", lineNumber, cue.ID)
+	}
+
+	m := timingLineRegex.FindStringSubmatch(b.lines[timingIndex])
+	if len(m) == 0 {
+		return VTTCue{}, fmt.Errorf("line %d: [%s] is not a WebVTT timing line", lineNumber, b.lines[timingIndex])
+	}
+	for _, hours := range []string{m[1], m[5]} {
+		if _, err := strconv.ParseUint(hours, 10, 32); hours != "" && err != nil {
+			return VTTCue{}, fmt.Errorf("line %d: [%s] has too many hours", lineNumber, b.lines[timingIndex])
+		}
+	}
+	cue.Range = timecode.Range{Start: fromMatch(m, 1), End: fromMatch(m, 5)}
+	cue.Settings = m[9]
+	if rest := b.lines[timingIndex+1:]; len(rest) > 0 {
+		cue.Lines = append([]string(nil), rest...)
+	}
+	return cue, nil
+}
+
+func fromMatch(m []string, from int) timecode.Timecode {
+	return timecode.FromParams(false,
+		parseNumber(m[from]), parseNumber(m[from+1]), parseNumber(m[from+2]), parseNumber(m[from+3]))
+}
+
+func parseNumber(str string) uint64 {
+	if str == "" {
+		return 0
+	}
+	i, _ := strconv.ParseUint(str, 10, 64)
+	return i
+}
+
+func isHeader(line string) bool {
+	return hasKeyword(line, "WEBVTT")
+}
+
+func isIgnoredBlock(line string) bool {
+	return hasKeyword(line, "NOTE") || hasKeyword(line, "STYLE") || hasKeyword(line, "REGION")
+}
+
+// hasKeyword is true if line is keyword, or starts with keyword followed by
+// a space or tab.
+func hasKeyword(line, keyword string) bool {
+	if !strings.HasPrefix(line, keyword) {
+		return false
+	}
+	rest := line[len(keyword):]
+	return rest == "" || rest[0] == ' ' || rest[0] == '\t'
+}
@@ -0,0 +1,184 @@
+package vtt_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/liampulles/go-timecode"
+	"github.com/liampulles/go-timecode/vtt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVTTFile_ValidCase(t *testing.T) {
+	// Setup fixture
+	input := "\uFEFFWEBVTT - Some title\r\n" +
+		"Kind: captions\r\n" +
+		"\r\n" +
+		"STYLE\n" +
+		"::cue { color: yellow }\n" +
+		"\n" +
+		"NOTE this is ignored\n" +
+		"\n" +
+		"intro\n" +
+		"00:01.000 --> 00:02.500\n" +
+		"Hello there!\n" +
+		"\n\n" +
+		"01:00:03.000 --> 01:00:04.000 position:10% align:start\n" +
+		"- General Kenobi!\n" +
+		"- You are a bold one.\n" +
+		"\n" +
+		"100:00:00.000 --> 100:00:01.000\n"
+
+	// Setup expectations
+	expected := []vtt.VTTCue{
+		{
+			ID:    "intro",
+			Range: timecode.Range{Start: timecode.Second, End: 2*timecode.Second + 500*timecode.Millisecond},
+			Lines: []string{"Hello there!"},
+		},
+		{
+			Range:    timecode.Range{Start: timecode.Hour + 3*timecode.Second, End: timecode.Hour + 4*timecode.Second},
+			Settings: "position:10% align:start",
+			Lines:    []string{"- General Kenobi!", "- You are a bold one."},
+		},
+		{
+			Range: timecode.Range{Start: 100 * timecode.Hour, End: 100*timecode.Hour + timecode.Second},
+		},
+	}
+
+	// Exercise SUT
+	actual, err := vtt.ParseVTTFile(strings.NewReader(input))
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestParseVTTFile_NoCues(t *testing.T) {
+	// Exercise SUT
+	actual, err := vtt.ParseVTTFile(strings.NewReader("WEBVTT\n"))
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, []vtt.VTTCue{}, actual)
+}
+
+func TestParseVTTFile_InvalidCases(t *testing.T) {
+	// Setup expectations
+	var tests = []struct {
+		input    string
+		expected string
+	}{
+		{
+			"",
+			"line 1: missing WEBVTT header",
+		},
+		{
+			"WEBVTTX\n",
+			"line 1: missing WEBVTT header",
+		},
+		{
+			"00:01.000 --> 00:02.000\nHello\n",
+			"line 1: missing WEBVTT header",
+		},
+		{
+			"WEBVTT\n\nintro\n",
+			"line 4: cue [intro] has no timing line",
+		},
+		{
+			"WEBVTT\n\n00:01,000 --> 00:02,000\n",
+			"line 3: [00:01,000 --> 00:02,000] is not a WebVTT timing line",
+		},
+		{
+			"WEBVTT\n\nintro\n0:01.000 --> 00:02.000\n",
+			"line 4: [0:01.000 --> 00:02.000] is not a WebVTT timing line",
+		},
+		{
+			"WEBVTT\n\n00:01.000 --> 00:60.000\n",
+			"line 3: [00:01.000 --> 00:60.000] is not a WebVTT timing line",
+		},
+		{
+			"WEBVTT\n\n99999999999999999999:00:00.000 --> 99999999999999999999:00:01.000\n",
+			"line 3: [99999999999999999999:00:00.000 --> 99999999999999999999:00:01.000] has too many hours",
+		},
+		{
+			"WEBVTT\n\n00:00.000 --> 99999999999999999999:00:01.000\n",
+			"line 3: [00:00.000 --> 99999999999999999999:00:01.000] has too many hours",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("[%d]", i), func(t *testing.T) {
+			// Exercise SUT
+			actual, err := vtt.ParseVTTFile(strings.NewReader(test.input))
+
+			// Verify result
+			assert.EqualError(t, err, test.expected)
+			assert.Nil(t, actual)
+		})
+	}
+}
+
+func TestFormatVTTFile_ValidCase(t *testing.T) {
+	// Setup fixture
+	cues := []vtt.VTTCue{
+		{
+			ID:       "intro",
+			Range:    timecode.Range{Start: timecode.Second, End: 2*timecode.Second + 500*timecode.Millisecond},
+			Settings: "align:start",
+			Lines:    []string{"Hello there!"},
+		},
+		{
+			Range: timecode.Range{Start: timecode.Hour, End: timecode.Hour + timecode.Second},
+			Lines: []string{"- General Kenobi!", "- You are a bold one."},
+		},
+	}
+	var buf bytes.Buffer
+
+	// Exercise SUT
+	err := vtt.FormatVTTFile(cues, &buf)
+
+	// Verify result
+	assert.NoError(t, err)
+	assert.Equal(t, "WEBVTT\n"+
+		"\n"+
+		"intro\n"+
+		"00:00:01.000 --> 00:00:02.500 align:start\n"+
+		"Hello there!\n"+
+		"\n"+
+		"01:00:00.000 --> 01:00:01.000\n"+
+		"- General Kenobi!\n"+
+		"- You are a bold one.\n", buf.String())
+
+	roundTrip, err := vtt.ParseVTTFile(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, cues, roundTrip)
+}
+
+func TestFormatVTTFile_NegativeCase(t *testing.T) {
+	// Setup fixture
+	cues := []vtt.VTTCue{{Range: timecode.Range{Start: -timecode.Second, End: timecode.Second}}}
+
+	// Exercise SUT
+	err := vtt.FormatVTTFile(cues, &bytes.Buffer{})
+
+	// Verify result
+	assert.EqualError(t, err, "cue [0]: [-00:00:01.000 --> 00:00:01.000] has a negative timestamp")
+}
+
+func TestFormatVTTFile_WriterError(t *testing.T) {
+	// Exercise SUT
+	err := vtt.FormatVTTFile(nil, failingWriter{})
+
+	// Verify result
+	assert.Error(t, err)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}